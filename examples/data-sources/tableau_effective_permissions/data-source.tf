@@ -0,0 +1,9 @@
+data "tableau_effective_permissions" "example" {
+  user_id      = tableau_user.example.id
+  content_type = "workbook"
+  content_id   = tableau_workbook.example.id
+}
+
+output "denied_capabilities" {
+  value = [for capability in data.tableau_effective_permissions.example.capabilities : capability.name if capability.mode == "Deny"]
+}
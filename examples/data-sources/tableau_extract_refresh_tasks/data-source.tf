@@ -0,0 +1,5 @@
+data "tableau_extract_refresh_tasks" "all" {}
+
+output "failing_extract_refresh_task_ids" {
+  value = [for task in data.tableau_extract_refresh_tasks.all.tasks : task.id if task.consecutive_failed_count > 0]
+}
@@ -0,0 +1,9 @@
+data "tableau_jobs" "failed_refreshes" {
+  job_type      = "refresh_extracts"
+  status        = "Failed"
+  created_after = timeadd(plantimestamp(), "-24h")
+}
+
+output "failed_refreshes" {
+  value = [for job in data.tableau_jobs.failed_refreshes.jobs : "${job.title} (${job.created_at})"]
+}
@@ -0,0 +1,25 @@
+data "tableau_permission_template" "workbook_explore" {
+  content_type = "workbook"
+  template     = "Explore"
+}
+
+resource "tableau_workbook_permissions" "example" {
+  workbook_id = tableau_workbook.example.id
+
+  grantee_capabilities = [
+    {
+      group_id     = tableau_group.explorers.id
+      capabilities = data.tableau_permission_template.workbook_explore.capabilities
+    },
+  ]
+}
+
+# the single rule resources can be expanded from the names
+resource "tableau_workbook_permission" "example" {
+  for_each = toset(data.tableau_permission_template.workbook_explore.capability_names)
+
+  workbook_id     = tableau_workbook.other.id
+  group_id        = tableau_group.explorers.id
+  capability_name = each.value
+  capability_mode = "Allow"
+}
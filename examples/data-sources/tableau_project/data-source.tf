@@ -0,0 +1,8 @@
+data "tableau_project" "by_id" {
+  id = "xxxxx-xxxxx-xxxxx"
+}
+
+# look a nested project up by the names of it and the projects above it
+data "tableau_project" "monthly" {
+  path = "Finance/Reporting/Monthly"
+}
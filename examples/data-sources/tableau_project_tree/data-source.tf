@@ -0,0 +1,19 @@
+data "tableau_project_tree" "all" {
+}
+
+resource "tableau_project_permissions" "reporting" {
+  project_id = data.tableau_project_tree.all.project_ids_by_path["Finance/Reporting"]
+
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.finance.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+      ]
+    },
+  ]
+}
+
+output "top_level_projects" {
+  value = [for project in data.tableau_project_tree.all.projects : project.name if project.depth == 0]
+}
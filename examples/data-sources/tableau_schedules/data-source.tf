@@ -0,0 +1,7 @@
+data "tableau_schedules" "extracts" {
+  type = "Extract"
+}
+
+output "extract_schedule_ids" {
+  value = { for schedule in data.tableau_schedules.extracts.schedules : schedule.name => schedule.id }
+}
@@ -0,0 +1,7 @@
+data "tableau_subscriptions" "chief_executive" {
+  user_id = tableau_user.chief_executive.id
+}
+
+output "chief_executive_subscriptions" {
+  value = [for subscription in data.tableau_subscriptions.chief_executive.subscriptions : subscription.subject]
+}
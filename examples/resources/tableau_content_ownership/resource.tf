@@ -0,0 +1,16 @@
+# hand a single workbook to a new owner
+resource "tableau_content_ownership" "sales_dashboard" {
+  content_type = "workbook"
+  content_id   = tableau_workbook.sales_dashboard.id
+  owner_id     = tableau_user.analyst.id
+}
+
+# before deprovisioning a user, transfer everything they own in a project
+# and the projects beneath it, the plan listing the content to be moved
+resource "tableau_content_ownership" "leaver" {
+  from_user_id            = tableau_user.leaver.id
+  project_id              = tableau_project.finance.id
+  include_nested_projects = true
+  content_types           = ["datasource", "flow", "workbook"]
+  owner_id                = tableau_user.manager.id
+}
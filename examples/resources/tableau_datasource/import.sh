@@ -0,0 +1 @@
+terraform import tableau_datasource.example "datasource_id"
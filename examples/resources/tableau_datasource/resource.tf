@@ -0,0 +1,20 @@
+resource "tableau_datasource" "example" {
+  name               = "Sales"
+  project_id         = tableau_project.example.id
+  file_path          = "${path.module}/datasources/sales.hyper"
+  publish_mode       = "Append"
+  description        = "Daily sales extract"
+  is_certified       = true
+  certification_note = "Reviewed by the data platform team"
+  encrypt_extracts   = true
+
+  connections = [
+    {
+      server_address = "warehouse.example.com"
+      server_port    = "5432"
+      username       = "tableau_reader"
+      password       = var.warehouse_password
+      embed_password = true
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import tableau_datasource_permissions.example "datasource_id"
@@ -0,0 +1,19 @@
+resource "tableau_datasource_permissions" "example" {
+  datasource_id = tableau_datasource.example.id
+
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.analysts.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Connect", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = tableau_user.contractor.id
+      capabilities = [
+        { name = "Connect", mode = "Deny" },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# the ID is the project ID and target type separated by a slash
+terraform import tableau_default_permissions.workbooks "project_id/workbooks"
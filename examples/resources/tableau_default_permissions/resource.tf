@@ -0,0 +1,15 @@
+resource "tableau_default_permissions" "workbooks" {
+  project_id    = tableau_project.example.id
+  target_type   = "workbooks"
+  authoritative = true
+
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.analysts.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "ExportData", mode = "Allow" },
+      ]
+    },
+  ]
+}
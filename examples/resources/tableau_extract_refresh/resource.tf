@@ -0,0 +1,15 @@
+variable "warehouse_load_id" {
+  type        = string
+  description = "ID of the warehouse load the extracts should reflect"
+}
+
+resource "tableau_extract_refresh" "orders" {
+  datasource_id = tableau_datasource.orders.id
+  triggers = {
+    warehouse_load = var.warehouse_load_id
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
@@ -0,0 +1 @@
+terraform import tableau_extract_refresh_task.example "task_id"
@@ -0,0 +1,18 @@
+# Tableau Server, adding a workbook to an existing schedule
+resource "tableau_extract_refresh_task" "sales" {
+  workbook_id = tableau_workbook.sales.id
+  schedule_id = tableau_schedule.nightly_extracts.id
+}
+
+# Tableau Cloud, where every task has a schedule of its own
+resource "tableau_extract_refresh_task" "orders" {
+  datasource_id = tableau_datasource.orders.id
+  type          = "IncrementalRefresh"
+  schedule = {
+    frequency      = "Hourly"
+    start_time     = "08:00:00"
+    end_time       = "18:00:00"
+    interval_hours = 2
+    week_days      = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+  }
+}
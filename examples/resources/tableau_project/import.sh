@@ -1 +1,4 @@
 terraform import tableau_project.example "project_id"
+
+# import a project from a site other than the provider's
+terraform import tableau_project.example "site_content_url::project_id"
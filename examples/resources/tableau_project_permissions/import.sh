@@ -0,0 +1 @@
+terraform import tableau_project_permissions.example "project_id"
@@ -0,0 +1,19 @@
+resource "tableau_project_permissions" "example" {
+  project_id = tableau_project.example.id
+
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.analysts.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = tableau_user.contractor.id
+      capabilities = [
+        { name = "Write", mode = "Deny" },
+      ]
+    },
+  ]
+}
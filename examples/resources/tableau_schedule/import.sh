@@ -0,0 +1 @@
+terraform import tableau_schedule.example "schedule_id"
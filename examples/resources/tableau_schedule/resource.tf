@@ -0,0 +1,26 @@
+resource "tableau_schedule" "nightly_extracts" {
+  name       = "Nightly extracts"
+  type       = "Extract"
+  frequency  = "Daily"
+  start_time = "02:00:00"
+  week_days  = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+}
+
+resource "tableau_schedule" "business_hours" {
+  name            = "Every 4 hours during business hours"
+  type            = "Extract"
+  priority        = 20
+  execution_order = "Serial"
+  frequency       = "Hourly"
+  start_time      = "08:00:00"
+  end_time        = "18:00:00"
+  interval_hours  = 4
+}
+
+resource "tableau_schedule" "month_end" {
+  name       = "Month end report"
+  type       = "Subscription"
+  frequency  = "Monthly"
+  start_time = "07:00:00"
+  month_days = ["LastDay"]
+}
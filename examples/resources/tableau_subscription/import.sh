@@ -0,0 +1 @@
+terraform import tableau_subscription.example "subscription_id"
@@ -0,0 +1,32 @@
+variable "sales_summary_view_id" {
+  type        = string
+  description = "ID of the sales summary view"
+}
+
+# Tableau Server, emailing a view on a subscription schedule
+resource "tableau_subscription" "sales_summary" {
+  subject      = "Weekly sales summary"
+  message      = "This week's figures, refreshed overnight"
+  content_type = "View"
+  content_id   = var.sales_summary_view_id
+  user_id      = tableau_user.sales_director.id
+  schedule_id  = tableau_schedule.monday_morning.id
+}
+
+# Tableau Cloud, where every subscription has a schedule of its own
+resource "tableau_subscription" "board_pack" {
+  subject            = "Board pack"
+  content_type       = "Workbook"
+  content_id         = tableau_workbook.board_pack.id
+  user_id            = tableau_user.chief_executive.id
+  attach_image       = false
+  attach_pdf         = true
+  page_orientation   = "LANDSCAPE"
+  page_size_option   = "A4"
+  send_if_view_empty = false
+  schedule = {
+    frequency  = "Monthly"
+    start_time = "07:00:00"
+    month_days = ["1"]
+  }
+}
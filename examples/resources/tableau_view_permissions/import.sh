@@ -0,0 +1 @@
+terraform import tableau_view_permissions.example "view_id"
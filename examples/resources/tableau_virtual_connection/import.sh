@@ -0,0 +1 @@
+terraform import tableau_virtual_connection.example "virtual_connection_id"
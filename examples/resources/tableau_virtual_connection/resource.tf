@@ -0,0 +1,16 @@
+resource "tableau_virtual_connection" "example" {
+  name               = "Warehouse"
+  project_id         = tableau_project.example.id
+  content            = file("${path.module}/virtual_connections/warehouse.json")
+  is_certified       = true
+  certification_note = "Reviewed by the data platform team"
+
+  connections = [
+    {
+      server_address = "warehouse.example.com"
+      server_port    = "5432"
+      username       = "tableau_reader"
+      password       = var.warehouse_password
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import tableau_virtual_connection_permissions.example "virtual_connection_id"
@@ -0,0 +1 @@
+terraform import tableau_workbook.example "workbook_id"
@@ -0,0 +1,17 @@
+resource "tableau_workbook" "example" {
+  name        = "Sales Dashboard"
+  project_id  = tableau_project.example.id
+  file_path   = "${path.module}/workbooks/sales_dashboard.twbx"
+  description = "Weekly sales by region"
+  show_tabs   = true
+
+  connections = [
+    {
+      server_address = "warehouse.example.com"
+      server_port    = "5432"
+      username       = "tableau_reader"
+      password       = var.warehouse_password
+      embed_password = true
+    },
+  ]
+}
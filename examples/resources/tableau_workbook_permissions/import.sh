@@ -0,0 +1 @@
+terraform import tableau_workbook_permissions.example "workbook_id"
@@ -0,0 +1,19 @@
+resource "tableau_workbook_permissions" "example" {
+  workbook_id = tableau_workbook.example.id
+
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.analysts.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "ExportData", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = tableau_user.contractor.id
+      capabilities = [
+        { name = "ExportData", mode = "Deny" },
+      ]
+    },
+  ]
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/gthesheep/terraform-provider-tableau/tableau"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -15,6 +16,14 @@ func main() {
 	err := providerserver.Serve(context.Background(), tableau.New, providerserver.ServeOpts{
 		Address: "registry.terraform.io/gthesheep/tableau",
 	})
+
+	// Terraform has finished with the provider, end any sessions it opened
+	signOutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if signOutErr := tableau.SignOutAll(signOutCtx); signOutErr != nil {
+		log.Printf("[WARN] failed to sign out of Tableau: %s", signOutErr)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
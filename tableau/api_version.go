@@ -0,0 +1,110 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// serverInfoApiVersion is the oldest REST API version serving serverinfo,
+// which every later version also answers without signing in
+const serverInfoApiVersion = "2.4"
+
+// virtualConnectionsApiVersion is the first REST API version with the
+// virtual connection methods
+const virtualConnectionsApiVersion = "3.18"
+
+// publishVirtualConnectionsApiVersion is the first REST API version able to
+// publish and update virtual connections
+const publishVirtualConnectionsApiVersion = "3.23"
+
+type ProductVersion struct {
+	Value string `json:"value"`
+	Build string `json:"build"`
+}
+
+type ServerInfo struct {
+	ProductVersion ProductVersion `json:"productVersion"`
+	RestApiVersion string         `json:"restApiVersion"`
+}
+
+type ServerInfoResponse struct {
+	ServerInfo ServerInfo `json:"serverInfo"`
+}
+
+// GetServerInfo reads the product and highest REST API version supported by
+// the server, before any session exists
+func (c *Client) GetServerInfo(ctx context.Context, server string) (*ServerInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/%s/serverinfo", server, serverInfoApiVersion), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	serverInfoResponse := ServerInfoResponse{}
+	err = json.Unmarshal(body, &serverInfoResponse)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parseApiVersion(serverInfoResponse.ServerInfo.RestApiVersion); err != nil {
+		return nil, fmt.Errorf("server reported an invalid REST API version: %w", err)
+	}
+	return &serverInfoResponse.ServerInfo, nil
+}
+
+func parseApiVersion(version string) ([2]int, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return [2]int{}, fmt.Errorf("expected a version of the form major.minor, got %q", version)
+	}
+	var parsed [2]int
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return [2]int{}, fmt.Errorf("expected a version of the form major.minor, got %q", version)
+		}
+		parsed[i] = number
+	}
+	return parsed, nil
+}
+
+// apiVersionAtLeast reports whether version is the same as or newer than
+// required, comparing numerically so 3.9 is older than 3.10
+func apiVersionAtLeast(version, required string) bool {
+	v, err := parseApiVersion(version)
+	if err != nil {
+		return false
+	}
+	r, err := parseApiVersion(required)
+	if err != nil {
+		return false
+	}
+	if v[0] != r[0] {
+		return v[0] > r[0]
+	}
+	return v[1] >= r[1]
+}
+
+// requireApiVersion warns when the client's REST API version is older than
+// the version feature needs, as its requests will likely be rejected
+func (c *Client) requireApiVersion(feature, required string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || c.ApiVersion == "" || apiVersionAtLeast(c.ApiVersion, required) {
+		return diags
+	}
+	diags.AddWarning(
+		"Unsupported Tableau REST API Version",
+		fmt.Sprintf("%s require REST API version %s or later, but the provider is using version %s. "+
+			"Requests are likely to fail unless the server is upgraded or a newer server_version is set.", feature, required, c.ApiVersion),
+	)
+	return diags
+}
@@ -0,0 +1,71 @@
+package tableau
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewClientNegotiatesApiVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/2.4/serverinfo":
+			if r.Header.Get("X-Tableau-Auth") != "" {
+				t.Errorf("expected serverinfo to be requested without a session")
+			}
+			w.Write([]byte(`{"serverInfo":{"productVersion":{"value":"2024.2.0","build":"20242.24.0711.1636"},"restApiVersion":"3.23"}}`))
+		case "/api/3.23/auth/signin":
+			w.Write([]byte(`{"credentials":{"site":{"id":"site-luid"},"token":"token"}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, site, username, password := server.URL, "", "admin", "secret"
+	c, err := NewClient(context.Background(), &serverURL, &username, &password, nil, nil, &site, nil, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error signing in: %s", err)
+	}
+	if c.ApiVersion != "3.23" {
+		t.Errorf("expected API version 3.23, got %s", c.ApiVersion)
+	}
+	if c.ApiUrl != server.URL+"/api/3.23/sites/site-luid" {
+		t.Errorf("unexpected API URL %s", c.ApiUrl)
+	}
+}
+
+func TestApiVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version  string
+		required string
+		expected bool
+	}{
+		{"3.18", "3.18", true},
+		{"3.10", "3.9", true},
+		{"3.9", "3.18", false},
+		{"4.0", "3.23", true},
+		{"2.8", "3.0", false},
+		{"latest", "3.0", false},
+	}
+	for _, test := range tests {
+		if got := apiVersionAtLeast(test.version, test.required); got != test.expected {
+			t.Errorf("apiVersionAtLeast(%q, %q) = %t, expected %t", test.version, test.required, got, test.expected)
+		}
+	}
+}
+
+func TestRequireApiVersionWarns(t *testing.T) {
+	c := &Client{ApiVersion: "3.17"}
+	diags := c.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+
+	c.ApiVersion = "3.18"
+	if diags := c.requireApiVersion("Virtual connections", virtualConnectionsApiVersion); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
@@ -1,18 +1,39 @@
 package tableau
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"sync"
 	"time"
 )
 
+const defaultRequestTimeout = 10 * time.Second
+
 type Client struct {
-	ApiUrl     string
-	HTTPClient *http.Client
-	AuthToken  string
+	ApiUrl        string
+	ApiVersion    string
+	HTTPClient    *http.Client
+	AuthToken     string
+	PageSize      int
+	RetryPolicy   RetryPolicy
+	JobPollPolicy JobPollPolicy
+
+	uploadChunkSize int64
+
+	baseUrl     string
+	credentials func() (Credentials, error)
+	refreshAt   time.Time
+	sessionMu   sync.RWMutex
+	signInMu    sync.Mutex
+
+	// siteContentUrl is the site this client is signed in to, with sites
+	// holding the clients signed in to any other sites resources ask for
+	siteContentUrl  string
+	siteCredentials func(contentUrl string) func() (Credentials, error)
+	sites           map[string]*Client
+	sitesMu         sync.Mutex
 }
 
 type SiteDetails struct {
@@ -21,10 +42,11 @@ type SiteDetails struct {
 }
 
 type Credentials struct {
-	Name        *string     `json:"name"`
-	Password    *string     `json:"password"`
-	TokenName   *string     `json:"personalAccessTokenName"`
-	TokenSecret *string     `json:"personalAccessTokenSecret"`
+	Name        *string     `json:"name,omitempty"`
+	Password    *string     `json:"password,omitempty"`
+	TokenName   *string     `json:"personalAccessTokenName,omitempty"`
+	TokenSecret *string     `json:"personalAccessTokenSecret,omitempty"`
+	JWT         *string     `json:"jwt,omitempty"`
 	SiteDetails SiteDetails `json:"site"`
 }
 
@@ -43,75 +65,173 @@ type SignInResponse struct {
 	SignInResponseData SignInResponseData `json:"credentials"`
 }
 
-func NewClient(server, username, password, personalAccessTokenName, personalAccessTokenSecret, site, serverVersion *string) (*Client, error) {
+func NewClient(ctx context.Context, server, username, password, personalAccessTokenName, personalAccessTokenSecret, site, serverVersion *string, connectedApp *ConnectedApp, retryPolicy *RetryPolicy, transportConfig *TransportConfig, requestTimeout time.Duration) (*Client, error) {
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient:    &http.Client{Timeout: requestTimeout},
+		RetryPolicy:   DefaultRetryPolicy(),
+		JobPollPolicy: DefaultJobPollPolicy(),
 	}
-
-	if (server != nil) && (username != nil) && (site != nil) && (serverVersion != nil) {
-		baseUrl := fmt.Sprintf("%s/api/%s", *server, *serverVersion)
-		url := fmt.Sprintf("%s/auth/signin", baseUrl)
-
-		siteStruct := SiteDetails{ContentUrl: *site}
-		credentials := Credentials{
-			Name:        username,
-			Password:    password,
-			TokenName:   personalAccessTokenName,
-			TokenSecret: personalAccessTokenSecret,
-			SiteDetails: siteStruct,
-		}
-		authRequest := SignInRequest{
-			Credentials: credentials,
-		}
-		authRequestJson, err := json.Marshal(authRequest)
+	if transportConfig != nil {
+		transport, err := transportConfig.newTransport()
 		if err != nil {
 			return nil, err
 		}
+		c.HTTPClient.Transport = transport
+	}
+	if retryPolicy != nil {
+		c.RetryPolicy = *retryPolicy
+	}
 
-		// authenticate
-		req, err := http.NewRequest("POST", url, strings.NewReader(string(authRequestJson)))
-		if err != nil {
-			return nil, err
+	if (server != nil) && (username != nil) && (site != nil) {
+		if serverVersion != nil {
+			c.ApiVersion = *serverVersion
 		}
-
-		body, err := c.doRequest(req)
-		if err != nil {
-			return nil, err
+		if c.ApiVersion == "" {
+			serverInfo, err := c.GetServerInfo(ctx, *server)
+			if err != nil {
+				return nil, fmt.Errorf("unable to determine the REST API version from serverinfo, set server_version to skip this: %w", err)
+			}
+			c.ApiVersion = serverInfo.RestApiVersion
+		}
+		c.baseUrl = fmt.Sprintf("%s/api/%s", *server, c.ApiVersion)
+
+		c.siteContentUrl = *site
+		c.siteCredentials = func(contentUrl string) func() (Credentials, error) {
+			siteStruct := SiteDetails{ContentUrl: contentUrl}
+			return func() (Credentials, error) {
+				if connectedApp != nil {
+					// a fresh JWT is needed for every sign in as each one may only be used once
+					jwt, err := connectedApp.NewJWT(time.Now())
+					if err != nil {
+						return Credentials{}, err
+					}
+					return Credentials{
+						JWT:         &jwt,
+						SiteDetails: siteStruct,
+					}, nil
+				}
+				return Credentials{
+					Name:        username,
+					Password:    password,
+					TokenName:   personalAccessTokenName,
+					TokenSecret: personalAccessTokenSecret,
+					SiteDetails: siteStruct,
+				}, nil
+			}
 		}
+		c.credentials = c.siteCredentials(c.siteContentUrl)
 
-		// parse response body
-		ar := SignInResponse{}
-		err = json.Unmarshal(body, &ar)
+		err := c.signIn(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		c.ApiUrl = fmt.Sprintf("%s/sites/%s", baseUrl, *ar.SignInResponseData.SiteDetails.ID)
-		c.AuthToken = ar.SignInResponseData.Token
 	}
 
 	return &c, nil
 }
 
+// doRequest sends req with the current session token, signing in again first
+// if the session is about to expire, or once afterwards if Tableau rejects the
+// token as no longer valid
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("X-Tableau-Auth", c.AuthToken)
+	ctx := req.Context()
+	if c.sessionExpiring() {
+		err := c.refreshSession(ctx, c.token())
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
+	token := c.token()
+	body, err := c.sendRequest(req, token)
+	if !hasStatusCode(err, http.StatusUnauthorized) || c.credentials == nil {
+		return body, err
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// the body has been consumed and cannot be replayed
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	err = c.refreshSession(ctx, token)
 	if err != nil {
 		return nil, err
 	}
+	if req.GetBody != nil {
+		req.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.sendRequest(req, c.token())
+}
+
+type fileTransferKey struct{}
+
+// fileTransfer marks a request sending a file, or committing an uploaded
+// one, as bounded only by its context, the request timeout being far too
+// short for a large file. The resource timeouts set the context deadline.
+func fileTransfer(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), fileTransferKey{}, true))
+}
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != 201) && (res.StatusCode != 204) {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+// httpClientFor returns the HTTP client to send req with, one without a
+// timeout for a file transfer
+func (c *Client) httpClientFor(req *http.Request) *http.Client {
+	if isFileTransfer, _ := req.Context().Value(fileTransferKey{}).(bool); !isFileTransfer || c.HTTPClient.Timeout == 0 {
+		return c.HTTPClient
 	}
+	untimed := *c.HTTPClient
+	untimed.Timeout = 0
+	return &untimed
+}
 
-	return body, err
+func (c *Client) sendRequest(req *http.Request, token string) ([]byte, error) {
+	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("X-Tableau-Auth", token)
+
+	httpClient := c.httpClientFor(req)
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, err := httpClient.Do(req)
+		if err != nil {
+			if req.Context().Err() == nil && c.RetryPolicy.shouldRetry(req, 0, attempt) {
+				if waitErr := waitForRetry(req.Context(), c.RetryPolicy.backoff(attempt, nil)); waitErr != nil {
+					return nil, waitErr
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if (res.StatusCode != http.StatusOK) && (res.StatusCode != 201) && (res.StatusCode != 204) {
+			if c.RetryPolicy.shouldRetry(req, res.StatusCode, attempt) {
+				if waitErr := waitForRetry(req.Context(), c.RetryPolicy.backoff(attempt, res)); waitErr != nil {
+					return nil, waitErr
+				}
+				continue
+			}
+			return nil, newAPIError(res.StatusCode, body)
+		}
+
+		return body, err
+	}
 }
@@ -4,6 +4,15 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
+)
+
+// default operation timeouts for resources exposing a timeouts block
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
 )
 
 type Owner struct {
@@ -0,0 +1,85 @@
+package tableau
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientSignsInWithConnectedApp(t *testing.T) {
+	connectedApp := &ConnectedApp{
+		ClientID:    "client-id",
+		SecretID:    "secret-id",
+		SecretValue: "secret-value",
+		Username:    "svc-terraform@example.com",
+		Scopes:      []string{"tableau:projects:*"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.21/auth/signin" {
+			t.Errorf("unexpected sign in path %s", r.URL.Path)
+		}
+		signInRequest := SignInRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&signInRequest); err != nil {
+			t.Fatalf("could not decode sign in request: %s", err)
+		}
+		credentials := signInRequest.Credentials
+		if credentials.JWT == nil || credentials.Name != nil || credentials.TokenName != nil {
+			t.Fatalf("expected only a jwt in the credentials, got %+v", credentials)
+		}
+		if credentials.SiteDetails.ContentUrl != "finance" {
+			t.Errorf("unexpected site %q", credentials.SiteDetails.ContentUrl)
+		}
+
+		parts := strings.Split(*credentials.JWT, ".")
+		if len(parts) != 3 {
+			t.Fatalf("expected a three part JWT, got %q", *credentials.JWT)
+		}
+		mac := hmac.New(sha256.New, []byte(connectedApp.SecretValue))
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+			t.Errorf("JWT signature does not match the secret value")
+		}
+
+		header := connectedAppHeader{}
+		decoded, _ := base64.RawURLEncoding.DecodeString(parts[0])
+		json.Unmarshal(decoded, &header)
+		if header.Algorithm != "HS256" || header.KeyID != "secret-id" || header.Issuer != "client-id" {
+			t.Errorf("unexpected JWT header %+v", header)
+		}
+		claims := connectedAppClaims{}
+		decoded, _ = base64.RawURLEncoding.DecodeString(parts[1])
+		json.Unmarshal(decoded, &claims)
+		if claims.Subject != connectedApp.Username || claims.Audience != "tableau" || claims.ID == "" {
+			t.Errorf("unexpected JWT claims %+v", claims)
+		}
+		if len(claims.Scopes) != 1 || claims.Scopes[0] != "tableau:projects:*" {
+			t.Errorf("unexpected JWT scopes %v", claims.Scopes)
+		}
+		if expiresIn := time.Until(time.Unix(claims.ExpiresAt, 0)); expiresIn <= 0 || expiresIn > 10*time.Minute {
+			t.Errorf("JWT expiry should be within 10 minutes, got %s", expiresIn)
+		}
+
+		w.Write([]byte(`{"credentials":{"site":{"id":"site-luid","contentUrl":"finance"},"user":{"id":"user-luid"},"token":"session-token"}}`))
+	}))
+	defer server.Close()
+
+	serverURL, serverVersion, site, empty := server.URL, "3.21", "finance", ""
+	c, err := NewClient(context.Background(), &serverURL, &empty, &empty, &empty, &empty, &site, &serverVersion, connectedApp, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if c.AuthToken != "session-token" {
+		t.Errorf("unexpected auth token %q", c.AuthToken)
+	}
+	if c.ApiUrl != server.URL+"/api/3.21/sites/site-luid" {
+		t.Errorf("unexpected api url %q", c.ApiUrl)
+	}
+}
@@ -0,0 +1,209 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ownershipContentTypes are the content types whose owner can be changed
+// through their update endpoint
+var ownershipContentTypes = []string{"datasource", "flow", "virtual_connection", "workbook"}
+
+// OwnedContent is a piece of content of any ownership content type, with
+// who owns it and the project it is in
+type OwnedContent struct {
+	ContentType string
+	ID          string
+	Name        string
+	OwnerID     string
+	ProjectID   string
+}
+
+func (c *Client) GetOwnedContent(ctx context.Context, contentType, contentID string) (*OwnedContent, error) {
+	switch contentType {
+	case "datasource":
+		datasource, err := c.GetDatasource(ctx, contentID, "")
+		if err != nil {
+			return nil, err
+		}
+		return &OwnedContent{contentType, datasource.ID, datasource.Name, datasource.Owner.ID, datasource.Project.ID}, nil
+	case "flow":
+		flow, err := c.GetFlow(ctx, contentID)
+		if err != nil {
+			return nil, err
+		}
+		return &OwnedContent{contentType, flow.ID, flow.Name, flow.Owner.ID, flow.Project.ID}, nil
+	case "virtual_connection":
+		virtualConnection, err := c.GetVirtualConnection(ctx, contentID)
+		if err != nil {
+			return nil, err
+		}
+		return &OwnedContent{contentType, virtualConnection.ID, virtualConnection.Name, virtualConnection.Owner.ID, virtualConnection.Project.ID}, nil
+	case "workbook":
+		workbook, err := c.GetWorkbook(ctx, contentID)
+		if err != nil {
+			return nil, err
+		}
+		return &OwnedContent{contentType, workbook.ID, workbook.Name, workbook.Owner.ID, workbook.Project.ID}, nil
+	}
+	return nil, fmt.Errorf("unknown content type %s", contentType)
+}
+
+// ListContentOwnedBy lists the content of a content type owned by a user.
+// Workbooks come from the user's own listing and data sources and flows from
+// the listing filtered by owner name, leaving virtual connections, which
+// cannot be filtered by owner, to be listed in full. As owner names are only
+// unique within a domain the owner ID still has to be checked.
+func (c *Client) ListContentOwnedBy(ctx context.Context, contentType string, owner User) ([]OwnedContent, error) {
+	ownerQuery := NewListQuery().FilterEqualsOrScan("ownerName", owner.Name)
+	contents := []OwnedContent{}
+	switch contentType {
+	case "datasource":
+		datasources, err := listAll[Datasource, DatasourceListResponse](ctx, c, fmt.Sprintf("%s/datasources", c.ApiUrl), ownerQuery)
+		if err != nil {
+			return nil, err
+		}
+		for _, datasource := range datasources {
+			contents = append(contents, OwnedContent{contentType, datasource.ID, datasource.Name, datasource.Owner.ID, datasource.Project.ID})
+		}
+	case "flow":
+		flows, err := listAll[Flow, FlowListResponse](ctx, c, fmt.Sprintf("%s/flows", c.ApiUrl), ownerQuery)
+		if err != nil {
+			return nil, err
+		}
+		for _, flow := range flows {
+			contents = append(contents, OwnedContent{contentType, flow.ID, flow.Name, flow.Owner.ID, flow.Project.ID})
+		}
+	case "virtual_connection":
+		virtualConnections, err := c.GetVirtualConnections(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, virtualConnection := range virtualConnections {
+			contents = append(contents, OwnedContent{contentType, virtualConnection.ID, virtualConnection.Name, virtualConnection.Owner.ID, virtualConnection.Project.ID})
+		}
+	case "workbook":
+		workbooks, err := listAll[Workbook, WorkbookListResponse](ctx, c, fmt.Sprintf("%s/users/%s/workbooks?ownedBy=true", c.ApiUrl, owner.ID), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, workbook := range workbooks {
+			contents = append(contents, OwnedContent{contentType, workbook.ID, workbook.Name, owner.ID, workbook.Project.ID})
+		}
+	default:
+		return nil, fmt.Errorf("unknown content type %s", contentType)
+	}
+	return contents, nil
+}
+
+// SetContentOwner transfers ownership of content to another user
+func (c *Client) SetContentOwner(ctx context.Context, contentType, contentID, ownerID string) error {
+	owner := &ContentReference{ID: ownerID}
+	var err error
+	switch contentType {
+	case "datasource":
+		_, err = c.UpdateDatasource(ctx, contentID, DatasourceUpdate{Owner: owner})
+	case "flow":
+		_, err = c.UpdateFlow(ctx, contentID, FlowUpdate{Owner: owner})
+	case "virtual_connection":
+		_, err = c.UpdateVirtualConnection(ctx, contentID, VirtualConnectionUpdate{Owner: owner})
+	case "workbook":
+		_, err = c.UpdateWorkbook(ctx, contentID, WorkbookUpdate{Owner: owner})
+	default:
+		err = fmt.Errorf("unknown content type %s", contentType)
+	}
+	return err
+}
+
+// FindContentOwnedBy lists the content of contentTypes owned by a user,
+// within projectID when it is set and, with includeNested, the projects
+// beneath it
+func (c *Client) FindContentOwnedBy(ctx context.Context, ownerID string, contentTypes []string, projectID string, includeNested bool) ([]OwnedContent, error) {
+	var projectIDs map[string]bool
+	if projectID != "" {
+		projectIDs = map[string]bool{projectID: true}
+		if includeNested {
+			projects, err := c.GetProjects(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectIDs = projectsBeneath(projects, projectID)
+		}
+	}
+
+	owner, err := c.GetUser(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := []OwnedContent{}
+	for _, contentType := range contentTypes {
+		contents, err := c.ListContentOwnedBy(ctx, contentType, *owner)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s content: %w", contentType, err)
+		}
+		owned = append(owned, filterOwnedContent(contents, ownerID, projectIDs)...)
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		if owned[i].ContentType != owned[j].ContentType {
+			return owned[i].ContentType < owned[j].ContentType
+		}
+		if owned[i].Name != owned[j].Name {
+			return owned[i].Name < owned[j].Name
+		}
+		return owned[i].ID < owned[j].ID
+	})
+	return owned, nil
+}
+
+// defaultOwnershipContentTypes are the content types transferred when none
+// are given, leaving out virtual connections where the API version has no
+// methods for them
+func (c *Client) defaultOwnershipContentTypes() []string {
+	contentTypes := []string{}
+	for _, contentType := range ownershipContentTypes {
+		if contentType == "virtual_connection" && c.ApiVersion != "" && !apiVersionAtLeast(c.ApiVersion, virtualConnectionsApiVersion) {
+			continue
+		}
+		contentTypes = append(contentTypes, contentType)
+	}
+	return contentTypes
+}
+
+// filterOwnedContent returns the contents owned by ownerID, limited to
+// projectIDs unless it is nil
+func filterOwnedContent(contents []OwnedContent, ownerID string, projectIDs map[string]bool) []OwnedContent {
+	owned := []OwnedContent{}
+	for _, content := range contents {
+		if content.OwnerID != ownerID {
+			continue
+		}
+		if projectIDs != nil && !projectIDs[content.ProjectID] {
+			continue
+		}
+		owned = append(owned, content)
+	}
+	return owned
+}
+
+// projectsBeneath returns projectID and the IDs of every project nested
+// beneath it, at any depth
+func projectsBeneath(projects []Project, projectID string) map[string]bool {
+	children := map[string][]string{}
+	for _, project := range projects {
+		children[project.ParentProjectID] = append(children[project.ParentProjectID], project.ID)
+	}
+	beneath := map[string]bool{}
+	pending := []string{projectID}
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if beneath[id] {
+			continue
+		}
+		beneath[id] = true
+		pending = append(pending, children[id]...)
+	}
+	return beneath
+}
@@ -0,0 +1,476 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &contentOwnershipResource{}
+	_ resource.ResourceWithConfigure  = &contentOwnershipResource{}
+	_ resource.ResourceWithModifyPlan = &contentOwnershipResource{}
+)
+
+func NewContentOwnershipResource() resource.Resource {
+	return &contentOwnershipResource{}
+}
+
+type contentOwnershipResource struct {
+	client *Client
+}
+
+type contentOwnershipResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	OwnerID               types.String `tfsdk:"owner_id"`
+	ContentType           types.String `tfsdk:"content_type"`
+	ContentID             types.String `tfsdk:"content_id"`
+	FromUserID            types.String `tfsdk:"from_user_id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	IncludeNestedProjects types.Bool   `tfsdk:"include_nested_projects"`
+	ContentTypes          types.Set    `tfsdk:"content_types"`
+	Transfers             types.List   `tfsdk:"transfers"`
+	PendingTransfers      types.List   `tfsdk:"pending_transfers"`
+	Site                  types.String `tfsdk:"site"`
+}
+
+type contentTransferModel struct {
+	ContentType     types.String `tfsdk:"content_type"`
+	ContentID       types.String `tfsdk:"content_id"`
+	Name            types.String `tfsdk:"name"`
+	PreviousOwnerID types.String `tfsdk:"previous_owner_id"`
+}
+
+var contentTransferType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"content_type":      types.StringType,
+		"content_id":        types.StringType,
+		"name":              types.StringType,
+		"previous_owner_id": types.StringType,
+	},
+}
+
+func (r *contentOwnershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_ownership"
+}
+
+func (r *contentOwnershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Transfer ownership of a piece of content, or of everything a user owns, to another user. " +
+			"The content to be transferred is shown in the plan, content later found with another owner is transferred again on the next apply, " +
+			"and destroying the resource leaves ownership unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the transfer, in the form content_type/content_id or from_user_id/project_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"owner_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user to transfer ownership to",
+			},
+			"content_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of the content to transfer, one of " + strings.Join(ownershipContentTypes, "/") + ", required with content_id",
+				Validators: []validator.String{
+					stringvalidator.OneOf(ownershipContentTypes...),
+					stringvalidator.AlsoRequires(path.MatchRoot("content_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the content to transfer, exactly one of content_id and from_user_id is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("from_user_id")),
+					stringvalidator.AlsoRequires(path.MatchRoot("content_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the user to transfer everything owned by, exactly one of content_id and from_user_id is required",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the project to limit a transfer from from_user_id to, defaults to every project",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("from_user_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_nested_projects": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Also transfer content in the projects nested beneath project_id, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"content_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Types of content to transfer from from_user_id, any of " + strings.Join(ownershipContentTypes, "/") +
+					", defaults to all of them (virtual connections needing REST API version " + virtualConnectionsApiVersion + ")",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(ownershipContentTypes...)),
+					setvalidator.AlsoRequires(path.MatchRoot("from_user_id")),
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"transfers": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Content transferred by the most recent apply, or to be transferred when shown in the plan creating the resource",
+				NestedObject: contentTransferAttribute("before the transfer"),
+			},
+			"pending_transfers": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Content found without owner_id as its owner when last refreshed, to be transferred by the next apply",
+				NestedObject: contentTransferAttribute("when last refreshed"),
+			},
+		},
+	}
+}
+
+// contentTransferAttribute describes a piece of content and who owned it at
+// the given point
+func contentTransferAttribute(ownedWhen string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"content_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the content",
+			},
+			"content_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the content",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the content",
+			},
+			"previous_owner_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user who owned the content " + ownedWhen,
+			},
+		},
+	}
+}
+
+// ModifyPlan looks up the content that would change owner so the plan
+// creating the resource previews the transfer. Once created, Read refreshes
+// the content still to be transferred and any found leaves the transfers to
+// be worked out again at apply time.
+func (r *contentOwnershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan contentOwnershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.FromUserID.IsNull() && plan.FromUserID.Equal(plan.OwnerID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner_id"),
+			"Invalid Ownership Transfer",
+			"owner_id must be a different user to from_user_id",
+		)
+		return
+	}
+	if plan.OwnerID.IsUnknown() || plan.ContentType.IsUnknown() || plan.ContentID.IsUnknown() || plan.FromUserID.IsUnknown() ||
+		plan.ProjectID.IsUnknown() || plan.IncludeNestedProjects.IsUnknown() || plan.ContentTypes.IsUnknown() || plan.Site.IsUnknown() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentTypes, diags := r.contentTypes(ctx, client, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if slices.Contains(contentTypes, "virtual_connection") {
+		resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+	}
+
+	// nothing is left to transfer once applied
+	noneLeft := types.ListValueMust(contentTransferType, []attr.Value{})
+
+	if req.State.Raw.IsNull() {
+		pending, err := r.pendingTransfers(ctx, client, plan, contentTypes)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Tableau Content Ownership",
+				"Could not find the content to transfer: "+err.Error(),
+			)
+			return
+		}
+		plan.Transfers, diags = types.ListValueFrom(ctx, contentTransferType, pending)
+		resp.Diagnostics.Append(diags...)
+		plan.PendingTransfers = noneLeft
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	var state contentOwnershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// transfers is unknown when the configuration changed
+	if plan.Transfers.IsUnknown() || len(state.PendingTransfers.Elements()) > 0 {
+		plan.Transfers = types.ListUnknown(contentTransferType)
+		plan.PendingTransfers = noneLeft
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+func (r *contentOwnershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contentOwnershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.transfer(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PendingTransfers = types.ListValueMust(contentTransferType, []attr.Value{})
+
+	if !plan.ContentID.IsNull() {
+		plan.ID = types.StringValue(plan.ContentType.ValueString() + "/" + plan.ContentID.ValueString())
+	} else {
+		plan.ID = types.StringValue(plan.FromUserID.ValueString() + "/" + plan.ProjectID.ValueString())
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *contentOwnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contentOwnershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentTypes, diags := r.contentTypes(ctx, client, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pending, err := r.pendingTransfers(ctx, client, state, contentTypes)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Content Ownership",
+			"Could not read the owners of the content to transfer: "+err.Error(),
+		)
+		return
+	}
+	state.PendingTransfers, diags = types.ListValueFrom(ctx, contentTransferType, pending)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *contentOwnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contentOwnershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the transfers are looked up again as ownership may have changed since
+	// the plan was made
+	plan.Transfers = types.ListUnknown(contentTransferType)
+	resp.Diagnostics.Append(r.transfer(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PendingTransfers = types.ListValueMust(contentTransferType, []attr.Value{})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete leaves the content with whoever owns it
+func (r *contentOwnershipResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *contentOwnershipResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+// transfer gives owner_id the content in the plan's transfers, or when they
+// were not known at plan time the content found now, setting transfers to
+// what was transferred
+func (r *contentOwnershipResource) transfer(ctx context.Context, client *Client, plan *contentOwnershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	transfers := []contentTransferModel{}
+	if plan.Transfers.IsUnknown() {
+		contentTypes, contentTypesDiags := r.contentTypes(ctx, client, *plan)
+		diags.Append(contentTypesDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		pending, err := r.pendingTransfers(ctx, client, *plan, contentTypes)
+		if err != nil {
+			diags.AddError(
+				"Error Transferring Tableau Content Ownership",
+				"Could not find the content to transfer: "+err.Error(),
+			)
+			return diags
+		}
+		transfers = pending
+	} else {
+		diags.Append(plan.Transfers.ElementsAs(ctx, &transfers, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, transfer := range transfers {
+		err := client.SetContentOwner(ctx, transfer.ContentType.ValueString(), transfer.ContentID.ValueString(), plan.OwnerID.ValueString())
+		if err != nil {
+			if IsNotFound(err) {
+				diags.AddWarning(
+					"Tableau Content Not Found",
+					fmt.Sprintf("The %s %s (%s) was deleted before its ownership could be transferred", transfer.ContentType.ValueString(), transfer.Name.ValueString(), transfer.ContentID.ValueString()),
+				)
+				continue
+			}
+			diags.AddError(
+				"Error Transferring Tableau Content Ownership",
+				fmt.Sprintf("Could not transfer the %s %s (%s) to user ID %s, unexpected error: %s", transfer.ContentType.ValueString(), transfer.Name.ValueString(), transfer.ContentID.ValueString(), plan.OwnerID.ValueString(), err),
+			)
+			return diags
+		}
+	}
+
+	plan.Transfers, diags = types.ListValueFrom(ctx, contentTransferType, transfers)
+	return diags
+}
+
+// contentTypes returns the content types to look for content to transfer in
+func (r *contentOwnershipResource) contentTypes(ctx context.Context, client *Client, plan contentOwnershipResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.ContentID.IsNull() {
+		return []string{plan.ContentType.ValueString()}, diags
+	}
+	if plan.ContentTypes.IsNull() {
+		return client.defaultOwnershipContentTypes(), diags
+	}
+	contentTypes := []string{}
+	diags.Append(plan.ContentTypes.ElementsAs(ctx, &contentTypes, false)...)
+	slices.Sort(contentTypes)
+	return contentTypes, diags
+}
+
+// pendingTransfers returns the content that does not yet belong to owner_id
+func (r *contentOwnershipResource) pendingTransfers(ctx context.Context, client *Client, plan contentOwnershipResourceModel, contentTypes []string) ([]contentTransferModel, error) {
+	var contents []OwnedContent
+	if !plan.ContentID.IsNull() {
+		content, err := client.GetOwnedContent(ctx, plan.ContentType.ValueString(), plan.ContentID.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if content.OwnerID != plan.OwnerID.ValueString() {
+			contents = append(contents, *content)
+		}
+	} else {
+		var err error
+		contents, err = client.FindContentOwnedBy(ctx, plan.FromUserID.ValueString(), contentTypes, plan.ProjectID.ValueString(), plan.IncludeNestedProjects.ValueBool())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transfers := []contentTransferModel{}
+	for _, content := range contents {
+		transfers = append(transfers, contentTransferModel{
+			ContentType:     types.StringValue(content.ContentType),
+			ContentID:       types.StringValue(content.ID),
+			Name:            types.StringValue(content.Name),
+			PreviousOwnerID: types.StringValue(content.OwnerID),
+		})
+	}
+	return transfers, nil
+}
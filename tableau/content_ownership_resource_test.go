@@ -0,0 +1,74 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContentOwnershipResource(t *testing.T) {
+	content := `
+resource "tableau_project" "test" {
+  name = "test_content_ownership"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_workbook" "test" {
+  name = "test_content_ownership"
+  project_id = tableau_project.test.id
+  file_path = "testdata/workbook.twb"
+}
+resource "tableau_user" "leaver" {
+  name = "test_content_ownership_leaver@test.test"
+  full_name = "test_content_ownership_leaver@test.test"
+  email = "test_content_ownership_leaver@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+resource "tableau_user" "successor" {
+  name = "test_content_ownership_successor@test.test"
+  full_name = "test_content_ownership_successor@test.test"
+  email = "test_content_ownership_successor@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + content + `
+resource "tableau_content_ownership" "workbook" {
+  content_type = "workbook"
+  content_id = tableau_workbook.test.id
+  owner_id = tableau_user.leaver.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_content_ownership.workbook", "transfers.#", "1"),
+					resource.TestCheckResourceAttr("tableau_content_ownership.workbook", "pending_transfers.#", "0"),
+					resource.TestCheckResourceAttrPair("tableau_content_ownership.workbook", "transfers.0.content_id", "tableau_workbook.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_content_ownership.workbook", "transfers.0.previous_owner_id"),
+				),
+			},
+			// Transfer everything the user owns in the project, the workbook
+			// keeping its owner when the single transfer is destroyed
+			{
+				Config: providerConfig + content + `
+resource "tableau_content_ownership" "leaver" {
+  from_user_id = tableau_user.leaver.id
+  project_id = tableau_project.test.id
+  owner_id = tableau_user.successor.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_content_ownership.leaver", "transfers.#", "1"),
+					resource.TestCheckResourceAttr("tableau_content_ownership.leaver", "pending_transfers.#", "0"),
+					resource.TestCheckResourceAttrPair("tableau_content_ownership.leaver", "transfers.0.content_id", "tableau_workbook.test", "id"),
+					resource.TestCheckResourceAttrPair("tableau_content_ownership.leaver", "transfers.0.previous_owner_id", "tableau_user.leaver", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFilterOwnedContent(t *testing.T) {
+	contents := []OwnedContent{
+		{ContentType: "workbook", ID: "1", OwnerID: "leaver", ProjectID: "finance"},
+		{ContentType: "workbook", ID: "2", OwnerID: "someone", ProjectID: "finance"},
+		{ContentType: "workbook", ID: "3", OwnerID: "leaver", ProjectID: "sales"},
+	}
+
+	if owned := filterOwnedContent(contents, "leaver", nil); len(owned) != 2 {
+		t.Errorf("expected content in every project without a project filter, got %v", owned)
+	}
+	owned := filterOwnedContent(contents, "leaver", map[string]bool{"finance": true})
+	if len(owned) != 1 || owned[0].ID != "1" {
+		t.Errorf("expected only the leaver's content in finance, got %v", owned)
+	}
+}
+
+func TestProjectsBeneath(t *testing.T) {
+	projects := []Project{
+		{ID: "top"},
+		{ID: "child", ParentProjectID: "top"},
+		{ID: "grandchild", ParentProjectID: "child"},
+		{ID: "other"},
+	}
+
+	beneath := projectsBeneath(projects, "top")
+	for _, id := range []string{"top", "child", "grandchild"} {
+		if !beneath[id] {
+			t.Errorf("expected %s beneath top", id)
+		}
+	}
+	if beneath["other"] {
+		t.Errorf("did not expect other beneath top")
+	}
+	if beneath := projectsBeneath(projects, "child"); len(beneath) != 2 || beneath["top"] {
+		t.Errorf("expected child and grandchild beneath child, got %v", beneath)
+	}
+}
+
+func TestFindContentOwnedByFiltersListings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pagination := `"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"1"}`
+		switch {
+		case r.URL.Path == "/users/leaver/":
+			fmt.Fprint(w, `{"user":{"id":"leaver","name":"leaver"}}`)
+		case r.URL.Path == "/users/leaver/workbooks" && r.URL.Query().Get("ownedBy") == "true":
+			fmt.Fprintf(w, `{%s,"workbooks":{"workbook":[{"id":"wb","name":"Sales","project":{"id":"finance"}}]}}`, pagination)
+		case r.URL.Path == "/datasources" && r.URL.Query().Get("filter") == "ownerName:eq:leaver":
+			// a namesake in another domain is left out by ID
+			fmt.Fprintf(w, `{%s,"datasources":{"datasource":[
+				{"id":"ds","name":"Orders","owner":{"id":"leaver"},"project":{"id":"finance"}},
+				{"id":"other","name":"Orders","owner":{"id":"namesake"},"project":{"id":"finance"}}
+			]}}`, pagination)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+	c := testRetryClient(server)
+
+	owned, err := c.FindContentOwnedBy(context.Background(), "leaver", []string{"datasource", "workbook"}, "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(owned) != 2 || owned[0].ID != "ds" || owned[1].ID != "wb" || owned[1].OwnerID != "leaver" {
+		t.Errorf("expected the leaver's data source and workbook, got %v", owned)
+	}
+}
@@ -1,9 +1,18 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+)
+
+// publish modes for a data source whose file has changed
+const (
+	DatasourcePublishOverwrite = "Overwrite"
+	DatasourcePublishAppend    = "Append"
 )
 
 type Tag struct {
@@ -37,6 +46,36 @@ type DatasourceRequest struct {
 	Datasource Datasource `json:"datasource"`
 }
 
+// PublishDatasource is the request payload sent alongside a data source file
+type PublishDatasource struct {
+	Name                string              `json:"name"`
+	Description         string              `json:"description,omitempty"`
+	UseRemoteQueryAgent string              `json:"useRemoteQueryAgent,omitempty"`
+	Project             ContentReference    `json:"project"`
+	Connections         *PublishConnections `json:"connections,omitempty"`
+}
+
+type PublishDatasourceRequest struct {
+	Datasource PublishDatasource `json:"datasource"`
+}
+
+// DatasourceUpdate holds the data source settings that can be changed without
+// publishing the file again
+type DatasourceUpdate struct {
+	Name                string            `json:"name,omitempty"`
+	Description         *string           `json:"description,omitempty"`
+	IsCertified         string            `json:"isCertified,omitempty"`
+	CertificationNote   *string           `json:"certificationNote,omitempty"`
+	EncryptExtracts     string            `json:"encryptExtracts,omitempty"`
+	UseRemoteQueryAgent string            `json:"useRemoteQueryAgent,omitempty"`
+	Project             *ContentReference `json:"project,omitempty"`
+	Owner               *ContentReference `json:"owner,omitempty"`
+}
+
+type DatasourceUpdateRequest struct {
+	Datasource DatasourceUpdate `json:"datasource"`
+}
+
 type DatasourceResponse struct {
 	Datasource Datasource `json:"datasource"`
 }
@@ -50,102 +89,126 @@ type DatasourceListResponse struct {
 	Pagination          PaginationDetails   `json:"pagination"`
 }
 
-func (c *Client) GetDatasources() ([]Datasource, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources", c.ApiUrl), nil)
+func (r DatasourceListResponse) pageItems() []Datasource {
+	return r.DatasourcesResponse.Datasources
+}
+
+func (r DatasourceListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetDatasources(ctx context.Context) ([]Datasource, error) {
+	return listAll[Datasource, DatasourceListResponse](ctx, c, fmt.Sprintf("%s/datasources", c.ApiUrl), nil)
+}
+
+func (c *Client) GetDatasource(ctx context.Context, datasourceID, name string) (*Datasource, error) {
+	if datasourceID != "" {
+		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		datasourceResponse := DatasourceResponse{}
+		err = json.Unmarshal(body, &datasourceResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &datasourceResponse.Datasource, nil
+	}
+
+	query := NewListQuery().FilterEqualsOrScan("name", name)
+	datasource, err := findFirst[Datasource, DatasourceListResponse](ctx, c, fmt.Sprintf("%s/datasources", c.ApiUrl), query, func(datasource Datasource) bool {
+		return datasource.Name == name
+	})
 	if err != nil {
 		return nil, err
 	}
+	if datasource == nil {
+		return nil, fmt.Errorf("Did not find datasource named %s: %w", name, ErrNotFound)
+	}
+	return datasource, nil
+}
 
-	body, err := c.doRequest(req)
+// PublishDatasource uploads a .tds, .tdsx, .hyper or .tde file. With no mode a
+// new data source is created, otherwise the data source of the same name in
+// the project is overwritten, or for extracts appended to. Files over 64 MB
+// are uploaded in chunks.
+func (c *Client) PublishDatasource(ctx context.Context, filePath string, datasource PublishDatasource, mode string) (*Datasource, error) {
+	datasourceType, err := publishFileType(filePath, "tds", "tdsx", "hyper", "tde")
 	if err != nil {
 		return nil, err
 	}
 
-	datasourceListResponse := DatasourceListResponse{}
-	err = json.Unmarshal(body, &datasourceListResponse)
+	query := url.Values{}
+	query.Set("datasourceType", datasourceType)
+	switch mode {
+	case DatasourcePublishOverwrite:
+		query.Set("overwrite", "true")
+	case DatasourcePublishAppend:
+		if datasourceType != "hyper" && datasourceType != "tde" {
+			return nil, fmt.Errorf("only .hyper and .tde extracts can be appended to, not %s", filePath)
+		}
+		query.Set("append", "true")
+	}
+
+	body, err := c.publishContent(ctx, fmt.Sprintf("%s/datasources", c.ApiUrl), query, PublishDatasourceRequest{Datasource: datasource}, "tableau_datasource", filePath)
 	if err != nil {
 		return nil, err
 	}
 
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(datasourceListResponse.Pagination)
+	datasourceResponse := DatasourceResponse{}
+	err = json.Unmarshal(body, &datasourceResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	allDatasources := make([]Datasource, 0, totalAvailable)
-	allDatasources = append(allDatasources, datasourceListResponse.DatasourcesResponse.Datasources...)
+	return &datasourceResponse.Datasource, nil
+}
 
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/datasources?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		datasourceListResponse = DatasourceListResponse{}
-		err = json.Unmarshal(body, &datasourceListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allDatasources = append(allDatasources, datasourceListResponse.DatasourcesResponse.Datasources...)
+func (c *Client) UpdateDatasource(ctx context.Context, datasourceID string, datasource DatasourceUpdate) (*Datasource, error) {
+	datasourceRequest := DatasourceUpdateRequest{
+		Datasource: datasource,
 	}
 
-	return allDatasources, nil
-}
-
-func (c *Client) GetDatasource(datasourceID, name string) (*Datasource, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources", c.ApiUrl), nil)
+	updateDatasourceJson, err := json.Marshal(datasourceRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), strings.NewReader(string(updateDatasourceJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	datasourceListResponse := DatasourceListResponse{}
-	err = json.Unmarshal(body, &datasourceListResponse)
+	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, _, err := GetPaginationNumbers(datasourceListResponse.Pagination)
+	datasourceResponse := DatasourceResponse{}
+	err = json.Unmarshal(body, &datasourceResponse)
 	if err != nil {
 		return nil, err
 	}
-	for i, datasource := range datasourceListResponse.DatasourcesResponse.Datasources {
-		if (datasource.ID == datasourceID) || (datasource.Name == name) {
-			return &datasourceListResponse.DatasourcesResponse.Datasources[i], nil
-		}
+
+	return &datasourceResponse.Datasource, nil
+}
+
+func (c *Client) DeleteDatasource(ctx context.Context, datasourceID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), nil)
+	if err != nil {
+		return err
 	}
 
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/datasources?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		datasourceListResponse = DatasourceListResponse{}
-		err = json.Unmarshal(body, &datasourceListResponse)
-		if err != nil {
-			return nil, err
-		}
-		// check if we found the datasource in this page
-		for i, datasource := range datasourceListResponse.DatasourcesResponse.Datasources {
-			if (datasource.ID == datasourceID) || (datasource.Name == name) {
-				return &datasourceListResponse.DatasourcesResponse.Datasources[i], nil
-			}
-		}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
 	}
 
-	return nil, fmt.Errorf("Did not find datasource ID %s", datasourceID)
+	return nil
 }
@@ -37,6 +37,7 @@ type datasourceDataSourceModel struct {
 	OwnerID             types.String `tfsdk:"owner_id"`
 	ProjectID           types.String `tfsdk:"project_id"`
 	Tags                types.List   `tfsdk:"tags"`
+	Site                types.String `tfsdk:"site"`
 }
 
 func (d *datasourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -52,6 +53,7 @@ func (d *datasourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:    true,
 				Description: "ID of the datasource",
 			},
+			"site": dataSourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -115,7 +117,13 @@ func (d *datasourceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	datasource, err := d.client.GetDatasource(state.ID.ValueString(), state.Name.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasource, err := client.GetDatasource(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Datasource",
@@ -144,7 +152,7 @@ func (d *datasourceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 	state.Tags, _ = types.ListValue(types.StringType, tags)
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,8 +28,8 @@ type DatasourcePermissionsResponse struct {
 	DatasourcePermissions DatasourcePermissions `json:"permissions"`
 }
 
-func (c *Client) GetDatasourcePermission(datasourceID, entityID, entityType, capabilityName, capabilityMode string) (*DatasourcePermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources/%s/permissions", c.ApiUrl, datasourceID), nil)
+func (c *Client) GetDatasourcePermission(ctx context.Context, datasourceID, entityID, entityType, capabilityName, capabilityMode string) (*DatasourcePermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/datasources/%s/permissions", c.ApiUrl, datasourceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +74,10 @@ func (c *Client) GetDatasourcePermission(datasourceID, entityID, entityType, cap
 			}
 		}
 	}
-	return nil, nil
+	return nil, fmt.Errorf("Did not find %s %s permission for %s ID %s on datasource ID %s: %w", capabilityMode, capabilityName, entityType, entityID, datasourceID, ErrNotFound)
 }
 
-func (c *Client) CreateDatasourcePermissions(datasourceID string, datasourcePermissions DatasourcePermissions) (*DatasourcePermissions, error) {
+func (c *Client) CreateDatasourcePermissions(ctx context.Context, datasourceID string, datasourcePermissions DatasourcePermissions) (*DatasourcePermissions, error) {
 
 	datasourcePermissionsRequest := DatasourcePermissionsRequest{
 		DatasourcePermissions: datasourcePermissions,
@@ -87,12 +88,12 @@ func (c *Client) CreateDatasourcePermissions(datasourceID string, datasourcePerm
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/datasources/%s/permissions", c.ApiUrl, datasourceID), strings.NewReader(string(newDatasourcePermissionsJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/datasources/%s/permissions", c.ApiUrl, datasourceID), strings.NewReader(string(newDatasourcePermissionsJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doRequest(retryablePermissionUpdate(req))
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (c *Client) CreateDatasourcePermissions(datasourceID string, datasourcePerm
 	return &datasourcePermissionsResponse.DatasourcePermissions, nil
 }
 
-func (c *Client) DeleteDatasourcePermission(userID, groupID *string, datasourceID, capabilityName, capabilityMode string) error {
+func (c *Client) DeleteDatasourcePermission(ctx context.Context, userID, groupID *string, datasourceID, capabilityName, capabilityMode string) error {
 	var entityID string
 	entityType := "users"
 	if userID != nil {
@@ -116,7 +117,7 @@ func (c *Client) DeleteDatasourcePermission(userID, groupID *string, datasourceI
 		entityID = *groupID
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/datasources/%s/permissions/%s/%s/%s/%s", c.ApiUrl, datasourceID, entityType, entityID, capabilityName, capabilityMode), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/datasources/%s/permissions/%s/%s/%s/%s", c.ApiUrl, datasourceID, entityType, entityID, capabilityName, capabilityMode), nil)
 
 	if err != nil {
 		return err
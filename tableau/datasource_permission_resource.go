@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,6 +35,7 @@ type datasourcePermissionResourceModel struct {
 	GroupID        types.String `tfsdk:"group_id"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
+	Site           types.String `tfsdk:"site"`
 }
 
 func (r *datasourcePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +51,7 @@ func (r *datasourcePermissionResource) Schema(_ context.Context, _ resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"datasource_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Datasource ID",
@@ -115,6 +116,12 @@ func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	datasourceID := plan.DatasourceID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -140,7 +147,7 @@ func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateDatasourcePermissions(datasourceID, datasourcePermissions)
+	_, err := client.CreateDatasourcePermissions(ctx, datasourceID, datasourcePermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating datasource permission",
@@ -166,10 +173,23 @@ func (r *datasourcePermissionResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getDatasourcePermissionFromID(state.ID.ValueString())
-	datasourcePermission, err := r.client.GetDatasourcePermission(permission.DatasourceID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	datasourcePermission, err := client.GetDatasourcePermission(ctx, permission.DatasourceID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Datasource Permission",
+			"Could not read Tableau datasource permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -213,9 +233,15 @@ func (r *datasourcePermissionResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getDatasourcePermissionFromID(state.ID.ValueString())
 	if permission.EntityType == "users" {
-		err := r.client.DeleteDatasourcePermission(&permission.EntityID, nil, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteDatasourcePermission(ctx, &permission.EntityID, nil, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Datasource",
@@ -224,7 +250,7 @@ func (r *datasourcePermissionResource) Delete(ctx context.Context, req resource.
 			return
 		}
 	} else {
-		err := r.client.DeleteDatasourcePermission(nil, &permission.EntityID, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteDatasourcePermission(ctx, nil, &permission.EntityID, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Datasource",
@@ -244,7 +270,7 @@ func (r *datasourcePermissionResource) Configure(_ context.Context, req resource
 }
 
 func (r *datasourcePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
 
 func getDatasourcePermissionID(datasourceID, entityType, entityID, capabilityName, capabilityMode string) string {
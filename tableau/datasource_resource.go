@@ -0,0 +1,470 @@
+package tableau
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &datasourceResource{}
+	_ resource.ResourceWithConfigure      = &datasourceResource{}
+	_ resource.ResourceWithImportState    = &datasourceResource{}
+	_ resource.ResourceWithModifyPlan     = &datasourceResource{}
+	_ resource.ResourceWithValidateConfig = &datasourceResource{}
+)
+
+func NewDatasourceResource() resource.Resource {
+	return &datasourceResource{}
+}
+
+type datasourceResource struct {
+	client *Client
+}
+
+type datasourceResourceModel struct {
+	ID                  types.String             `tfsdk:"id"`
+	Name                types.String             `tfsdk:"name"`
+	ProjectID           types.String             `tfsdk:"project_id"`
+	FilePath            types.String             `tfsdk:"file_path"`
+	ContentHash         types.String             `tfsdk:"content_hash"`
+	PublishMode         types.String             `tfsdk:"publish_mode"`
+	Description         types.String             `tfsdk:"description"`
+	IsCertified         types.Bool               `tfsdk:"is_certified"`
+	CertificationNote   types.String             `tfsdk:"certification_note"`
+	EncryptExtracts     types.Bool               `tfsdk:"encrypt_extracts"`
+	UseRemoteQueryAgent types.Bool               `tfsdk:"use_remote_query_agent"`
+	OwnerID             types.String             `tfsdk:"owner_id"`
+	Connections         []publishConnectionModel `tfsdk:"connections"`
+	HasExtracts         types.Bool               `tfsdk:"has_extracts"`
+	WebPageURL          types.String             `tfsdk:"web_page_url"`
+	LastUpdated         types.String             `tfsdk:"last_updated"`
+	Site                types.String             `tfsdk:"site"`
+	Timeouts            timeouts.Value           `tfsdk:"timeouts"`
+}
+
+func (r *datasourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource"
+}
+
+func (r *datasourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a data source from a local .tds, .tdsx, .hyper or .tde file",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the data source",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name for the data source",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier for the project the data source is published to",
+			},
+			"file_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to the .tds, .tdsx, .hyper or .tde file to publish",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file's content, the data source is published again using publish_mode whenever it changes",
+			},
+			"publish_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How a changed file is published, Overwrite replaces the data source while Append adds the rows of a .hyper or .tde extract to it - Overwrite is the default",
+				Default:     stringdefault.StaticString(DatasourcePublishOverwrite),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						DatasourcePublishOverwrite,
+						DatasourcePublishAppend,
+					}...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description for the data source",
+				Default:     stringdefault.StaticString(""),
+			},
+			"is_certified": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Mark the data source as certified, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"certification_note": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Note explaining the data source's certification",
+				Default:     stringdefault.StaticString(""),
+			},
+			"encrypt_extracts": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Encrypt the data source's extracts at rest, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"use_remote_query_agent": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Refresh the data source through Tableau Bridge, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the data source owner, defaults to the user the provider signs in as",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections": publishConnectionsAttribute("data source"),
+			"has_extracts": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the data source has extracts",
+			},
+			"web_page_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the data source in Tableau",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the data source",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig rejects appending a file that is not an extract, before
+// anything about the data source has been changed
+func (r *datasourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var filePath, publishMode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("file_path"), &filePath)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("publish_mode"), &publishMode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if publishMode.ValueString() != DatasourcePublishAppend || filePath.IsNull() || filePath.IsUnknown() {
+		return
+	}
+	if _, err := publishFileType(filePath.ValueString(), "hyper", "tde"); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("publish_mode"),
+			"Invalid Publish Mode",
+			"Only .hyper and .tde extracts can be appended to: "+err.Error(),
+		)
+	}
+}
+
+// ModifyPlan hashes the local file so a change to its content shows in the
+// plan as a change to content_hash
+func (r *datasourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPublishPlan(ctx, req, resp)
+}
+
+func (r *datasourceResource) publish(ctx context.Context, client *Client, plan datasourceResourceModel, mode string) (*Datasource, error) {
+	datasource := PublishDatasource{
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		UseRemoteQueryAgent: strconv.FormatBool(plan.UseRemoteQueryAgent.ValueBool()),
+		Project:             ContentReference{ID: plan.ProjectID.ValueString()},
+		Connections:         publishConnectionsFromModel(plan.Connections),
+	}
+	return client.PublishDatasource(ctx, plan.FilePath.ValueString(), datasource, mode)
+}
+
+// settings returns the update applying the plan's settings that are not part
+// of publishing
+func (r *datasourceResource) settings(plan datasourceResourceModel) DatasourceUpdate {
+	description := plan.Description.ValueString()
+	certificationNote := plan.CertificationNote.ValueString()
+	datasourceUpdate := DatasourceUpdate{
+		Name:                plan.Name.ValueString(),
+		Description:         &description,
+		IsCertified:         strconv.FormatBool(plan.IsCertified.ValueBool()),
+		CertificationNote:   &certificationNote,
+		EncryptExtracts:     strconv.FormatBool(plan.EncryptExtracts.ValueBool()),
+		UseRemoteQueryAgent: strconv.FormatBool(plan.UseRemoteQueryAgent.ValueBool()),
+		Project:             &ContentReference{ID: plan.ProjectID.ValueString()},
+	}
+	if !plan.OwnerID.IsUnknown() && !plan.OwnerID.IsNull() {
+		datasourceUpdate.Owner = &ContentReference{ID: plan.OwnerID.ValueString()}
+	}
+	return datasourceUpdate
+}
+
+func (r *datasourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan datasourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publishedDatasource, err := r.publish(ctx, client, plan, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error publishing data source",
+			"Could not publish data source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// certification, extract encryption and the owner can only be set once
+	// the data source exists
+	_, err = client.UpdateDatasource(ctx, publishedDatasource.ID, r.settings(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Data Source",
+			"Could not update the published data source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	datasource, err := client.GetDatasource(ctx, publishedDatasource.ID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Data Source",
+			"Could not read Tableau data source ID "+publishedDatasource.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(datasource.ID)
+	plan.OwnerID = types.StringValue(datasource.Owner.ID)
+	plan.HasExtracts = types.BoolValue(datasource.HasExtracts)
+	plan.WebPageURL = types.StringValue(datasource.WebPageURL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state datasourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasource, err := client.GetDatasource(ctx, state.ID.ValueString(), "")
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Data Source",
+			"Could not read Tableau data source ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(datasource.ID)
+	state.Name = types.StringValue(datasource.Name)
+	state.ProjectID = types.StringValue(datasource.Project.ID)
+	state.Description = types.StringValue(datasource.Description)
+	state.IsCertified = types.BoolValue(datasource.IsCertified)
+	state.CertificationNote = types.StringValue(datasource.CertificationNote)
+	state.EncryptExtracts = types.BoolValue(datasource.EncryptExtracts == "true")
+	state.UseRemoteQueryAgent = types.BoolValue(datasource.UseRemoteQueryAgent)
+	state.OwnerID = types.StringValue(datasource.Owner.ID)
+	state.HasExtracts = types.BoolValue(datasource.HasExtracts)
+	state.WebPageURL = types.StringValue(datasource.WebPageURL)
+	if state.PublishMode.IsNull() {
+		state.PublishMode = types.StringValue(DatasourcePublishOverwrite)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state datasourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rename or move the data source first, so publishing again replaces or
+	// appends to it rather than creating a new one alongside
+	_, err := client.UpdateDatasource(ctx, plan.ID.ValueString(), r.settings(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Data Source",
+			"Could not update data source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.ContentHash.Equal(state.ContentHash) || !publishConnectionsEqual(plan.Connections, state.Connections) {
+		_, err = r.publish(ctx, client, plan, plan.PublishMode.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error publishing data source",
+				"Could not publish data source in "+plan.PublishMode.ValueString()+" mode, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		// overwriting resets the settings that are not part of publishing
+		if plan.PublishMode.ValueString() == DatasourcePublishOverwrite {
+			_, err = client.UpdateDatasource(ctx, plan.ID.ValueString(), r.settings(plan))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Tableau Data Source",
+					"Could not update the published data source, unexpected error: "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	datasource, err := client.GetDatasource(ctx, plan.ID.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Data Source",
+			"Could not read Tableau data source ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.OwnerID = types.StringValue(datasource.Owner.ID)
+	plan.HasExtracts = types.BoolValue(datasource.HasExtracts)
+	plan.WebPageURL = types.StringValue(datasource.WebPageURL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state datasourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteDatasource(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Data Source",
+			"Could not delete data source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *datasourceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *datasourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
@@ -0,0 +1,69 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_datasource_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_datasource_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_datasource.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.test", "content_hash"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.test", "owner_id"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.test", "last_updated"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "name", "test_datasource_resource"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "publish_mode", "Overwrite"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "is_certified", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_datasource.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "content_hash", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_datasource_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_datasource_resource_renamed"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+  description = "Moo"
+  is_certified = true
+  certification_note = "Certified by Terraform"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource.test", "name", "test_datasource_resource_renamed"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "description", "Moo"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "is_certified", "true"),
+					resource.TestCheckResourceAttr("tableau_datasource.test", "certification_note", "Certified by Terraform"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
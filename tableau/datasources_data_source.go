@@ -32,6 +32,7 @@ type datasourcesNestedDataModel struct {
 type datasourcesDataSourceModel struct {
 	ID          types.String                 `tfsdk:"id"`
 	Datasources []datasourcesNestedDataModel `tfsdk:"datasources"`
+	Site        types.String                 `tfsdk:"site"`
 }
 
 func (d *datasourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (d *datasourcesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:    true,
 				Description: "ID of the list of datasources",
 			},
+			"site": dataSourceSiteAttribute(),
 			"datasources": schema.ListNestedAttribute{
 				Description: "List of datasources and their attributes",
 				Computed:    true,
@@ -83,7 +85,13 @@ func (d *datasourcesDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	datasources, err := d.client.GetDatasources()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasources, err := client.GetDatasources(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Datasources",
@@ -105,7 +113,7 @@ func (d *datasourcesDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	state.ID = types.StringValue("allDatasources")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,9 +1,8 @@
 package tableau
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 )
 
 var defaultPermissionTargetTypes = []string{
@@ -18,20 +17,27 @@ var defaultPermissionTargetTypes = []string{
 	"workbooks",
 }
 
-func (c *Client) GetDefaultPermissions(projectID, targetType string) (*ProjectPermissions, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/default-permissions/%s", c.ApiUrl, projectID, targetType), nil)
-	if err != nil {
-		return nil, err
-	}
+func defaultPermissionsEndpoint(apiUrl, projectID, targetType string) string {
+	return fmt.Sprintf("%s/projects/%s/default-permissions/%s", apiUrl, projectID, targetType)
+}
+
+func (c *Client) GetDefaultPermissions(ctx context.Context, projectID, targetType string) (*ProjectPermissions, error) {
+	return c.getPermissions(ctx, defaultPermissionsEndpoint(c.ApiUrl, projectID, targetType))
+}
+
+// AddDefaultPermissions adds default permissions for targetType content in a
+// project, leaving those already granted in place
+func (c *Client) AddDefaultPermissions(ctx context.Context, projectID, targetType string, permissions ProjectPermissions) (*ProjectPermissions, error) {
+	return c.addPermissions(ctx, defaultPermissionsEndpoint(c.ApiUrl, projectID, targetType), permissions)
+}
+
+func (c *Client) DeleteDefaultPermission(ctx context.Context, projectID, targetType string, rule PermissionRule) error {
+	return c.deletePermission(ctx, defaultPermissionsEndpoint(c.ApiUrl, projectID, targetType), rule)
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	projectPermissionsResponse := ProjectPermissionsResponse{}
-	err = json.Unmarshal(body, &projectPermissionsResponse)
-	if err != nil {
-		return nil, err
-	}
-	return &projectPermissionsResponse.ProjectPermissions, nil
+// SetDefaultPermissions makes the default permissions for targetType content
+// in a project match desired. When managed is set only the rules in it may be
+// deleted, otherwise every rule not in desired is.
+func (c *Client) SetDefaultPermissions(ctx context.Context, projectID, targetType string, desired, managed []PermissionRule) error {
+	return c.setPermissions(ctx, defaultPermissionsEndpoint(c.ApiUrl, projectID, targetType), desired, managed)
 }
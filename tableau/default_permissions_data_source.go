@@ -37,6 +37,7 @@ type defaultPermissionsDataSourceModel struct {
 	ProjectID           types.String             `tfsdk:"project_id"`
 	TargetType          types.String             `tfsdk:"target_type"`
 	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+	Site                types.String             `tfsdk:"site"`
 }
 
 func (d *defaultPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +48,7 @@ func (d *defaultPermissionsDataSource) Schema(_ context.Context, _ datasource.Sc
 	resp.Schema = schema.Schema{
 		Description: "Retrieve project details",
 		Attributes: map[string]schema.Attribute{
+			"site": dataSourceSiteAttribute(),
 			"project_id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the project",
@@ -96,7 +98,13 @@ func (d *defaultPermissionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	perms, err := d.client.GetDefaultPermissions(state.ProjectID.ValueString(), state.TargetType.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, err := client.GetDefaultPermissions(ctx, state.ProjectID.ValueString(), state.TargetType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project Permissions",
@@ -126,7 +134,7 @@ func (d *defaultPermissionsDataSource) Read(ctx context.Context, req datasource.
 			Capabilities: newCapabilities,
 		})
 	}
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,327 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &defaultPermissionsResource{}
+	_ resource.ResourceWithConfigure   = &defaultPermissionsResource{}
+	_ resource.ResourceWithImportState = &defaultPermissionsResource{}
+)
+
+func NewDefaultPermissionsResource() resource.Resource {
+	return &defaultPermissionsResource{}
+}
+
+type defaultPermissionsResource struct {
+	client *Client
+}
+
+type defaultPermissionsResourceModel struct {
+	ID                  types.String             `tfsdk:"id"`
+	ProjectID           types.String             `tfsdk:"project_id"`
+	TargetType          types.String             `tfsdk:"target_type"`
+	Authoritative       types.Bool               `tfsdk:"authoritative"`
+	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+	Site                types.String             `tfsdk:"site"`
+}
+
+func (r *defaultPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_permissions"
+}
+
+func (r *defaultPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the default permissions a project applies to a type of content",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the default permissions, in the form project_id/target_type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of content the defaults apply to, one of " + strings.Join(defaultPermissionTargetTypes, "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(defaultPermissionTargetTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Remove every default permission for the target type not in grantee_capabilities, rather than only managing the rules listed and replacing any granting a listed capability in the other mode, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"grantee_capabilities": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Users and groups granted default capabilities",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the user, exactly one of user_id and group_id is required",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("group_id")),
+							},
+						},
+						"group_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the group, exactly one of user_id and group_id is required",
+						},
+						"capabilities": schema.SetNestedAttribute{
+							Required:    true,
+							Description: "Capabilities of the user or group, the names valid depending on the target type",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "Name of the capability",
+									},
+									"mode": schema.StringAttribute{
+										Required:    true,
+										Description: "Capability mode, Allow or Deny (case sensitive)",
+										Validators: []validator.String{
+											stringvalidator.OneOf([]string{
+												"Allow",
+												"Deny",
+											}...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *defaultPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan defaultPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := desiredPermissionRules(plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("grantee_capabilities"), "Invalid Grantee Capabilities", err.Error())
+		return
+	}
+
+	var managed []PermissionRule
+	if !plan.Authoritative.ValueBool() {
+		managed = desired
+	}
+	err = client.SetDefaultPermissions(ctx, plan.ProjectID.ValueString(), plan.TargetType.ValueString(), desired, managed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tableau Default Permissions",
+			"Could not create default permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getDefaultPermissionsID(plan.ProjectID.ValueString(), plan.TargetType.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state defaultPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, targetType, err := getDefaultPermissionsFromID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Default Permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	permissions, err := client.GetDefaultPermissions(ctx, projectID, targetType)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Default Permissions",
+			"Could not read Tableau default permissions ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// an import reads every rule, after which they are all managed
+	granteeCapabilities := permissions.GranteeCapabilities
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(false)
+	} else if !state.Authoritative.ValueBool() {
+		managed, err := desiredPermissionRules(state.GranteeCapabilities)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Tableau Default Permissions", err.Error())
+			return
+		}
+		granteeCapabilities = granteeCapabilitiesFromRules(intersectPermissionRules(permissionRules(granteeCapabilities), managed))
+	}
+
+	state.ProjectID = types.StringValue(projectID)
+	state.TargetType = types.StringValue(targetType)
+	state.GranteeCapabilities = granteeCapabilityModels(granteeCapabilities)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state defaultPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := desiredPermissionRules(plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("grantee_capabilities"), "Invalid Grantee Capabilities", err.Error())
+		return
+	}
+
+	// rules dropped from the configuration are still managed until deleted
+	var managed []PermissionRule
+	if !plan.Authoritative.ValueBool() {
+		previous, err := desiredPermissionRules(state.GranteeCapabilities)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Updating Tableau Default Permissions", err.Error())
+			return
+		}
+		managed = append(previous, desired...)
+	}
+	err = client.SetDefaultPermissions(ctx, plan.ProjectID.ValueString(), plan.TargetType.ValueString(), desired, managed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Default Permissions",
+			"Could not update default permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *defaultPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state defaultPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := desiredPermissionRules(state.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Tableau Default Permissions", err.Error())
+		return
+	}
+	for _, rule := range rules {
+		err := client.DeleteDefaultPermission(ctx, state.ProjectID.ValueString(), state.TargetType.ValueString(), rule)
+		if err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Tableau Default Permissions",
+				"Could not delete default permission "+rule.String()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *defaultPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *defaultPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
+
+func getDefaultPermissionsID(projectID, targetType string) string {
+	return fmt.Sprintf("%s/%s", projectID, targetType)
+}
+
+func getDefaultPermissionsFromID(defaultPermissionsID string) (string, string, error) {
+	parts := strings.Split(defaultPermissionsID, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("wrong number of items in ID (%d vs. 2) in %s", len(parts), defaultPermissionsID)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,87 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDefaultPermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_default_permissions"
+  content_permissions = "LockedToProject"
+}
+resource "tableau_group" "test" {
+  name = "test_default_permissions"
+  minimum_site_role = "Viewer"
+}
+resource "tableau_default_permissions" "test" {
+  project_id = tableau_project.test.id
+  target_type = "workbooks"
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.test.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "ExportData", mode = "Deny" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_default_permissions.test", "id"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "target_type", "workbooks"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "grantee_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "grantee_capabilities.0.capabilities.#", "2"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_default_permissions"
+  content_permissions = "LockedToProject"
+}
+resource "tableau_group" "test" {
+  name = "test_default_permissions"
+  minimum_site_role = "Viewer"
+}
+resource "tableau_default_permissions" "test" {
+  project_id = tableau_project.test.id
+  target_type = "workbooks"
+  authoritative = true
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.test.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "authoritative", "true"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "grantee_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("tableau_default_permissions.test", "grantee_capabilities.0.capabilities.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_default_permissions.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authoritative"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,270 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// sources of an effective capability, in order of precedence
+const (
+	EffectiveSourceSiteRole      = "site_role"
+	EffectiveSourceOwner         = "owner"
+	EffectiveSourceProjectLeader = "project_leader"
+	EffectiveSourceUser          = "user"
+	EffectiveSourceGroup         = "group"
+	EffectiveSourceUnspecified   = "unspecified"
+)
+
+// administratorSiteRoles have every capability on all content in the site
+var administratorSiteRoles = []string{
+	"ServerAdministrator",
+	"SiteAdministrator",
+	"SiteAdministratorCreator",
+	"SiteAdministratorExplorer",
+}
+
+// EffectiveCapability is whether a user ends up with a capability, Mode
+// being None when no rule grants or denies it, along with the rules it was
+// decided by
+type EffectiveCapability struct {
+	Name   string
+	Mode   string
+	Source string
+	Rules  []PermissionRule
+}
+
+type EffectivePermissions struct {
+	// LockedProjectID is the project whose permissions apply in place of the
+	// content's own, empty when the content's project is not locked
+	LockedProjectID string
+	Capabilities    []EffectiveCapability
+}
+
+// effectivePermissionsContent describes where each content type's
+// permissions live
+type effectivePermissionsContent struct {
+	permissionsType string
+	// defaultTarget is the project default permissions applying to the
+	// content when its project is locked
+	defaultTarget string
+	capabilities  []string
+}
+
+var effectivePermissionsContentTypes = map[string]effectivePermissionsContent{
+	"project":            {permissionsType: "projects", capabilities: projectCapabilities},
+	"workbook":           {permissionsType: "workbooks", defaultTarget: "workbooks", capabilities: workbookCapabilities},
+	"datasource":         {permissionsType: "datasources", defaultTarget: "datasources", capabilities: datasourceCapabilities},
+	"view":               {permissionsType: "views", defaultTarget: "workbooks", capabilities: viewCapabilities},
+	"virtual_connection": {permissionsType: "virtualconnections", defaultTarget: "virtualconnections", capabilities: virtualConnectionCapabilities},
+}
+
+// resolveEffectiveCapabilities applies Tableau's precedence to the rules on
+// a piece of content: rules for the user win over rules for their groups,
+// and within either a Deny wins over an Allow
+func resolveEffectiveCapabilities(capabilities []string, rules []PermissionRule, userID string, memberOf map[string]bool) []EffectiveCapability {
+	effectiveCapabilities := []EffectiveCapability{}
+	for _, capability := range capabilities {
+		var userRules, groupRules []PermissionRule
+		for _, rule := range rules {
+			if rule.CapabilityName != capability {
+				continue
+			}
+			if rule.EntityType == "users" && rule.EntityID == userID {
+				userRules = append(userRules, rule)
+			} else if rule.EntityType == "groups" && memberOf[rule.EntityID] {
+				groupRules = append(groupRules, rule)
+			}
+		}
+
+		effectiveCapability := EffectiveCapability{
+			Name:   capability,
+			Mode:   "None",
+			Source: EffectiveSourceUnspecified,
+			Rules:  []PermissionRule{},
+		}
+		if len(userRules) > 0 {
+			effectiveCapability.Source = EffectiveSourceUser
+			effectiveCapability.Rules = userRules
+		} else if len(groupRules) > 0 {
+			effectiveCapability.Source = EffectiveSourceGroup
+			effectiveCapability.Rules = groupRules
+		}
+		if len(effectiveCapability.Rules) > 0 {
+			effectiveCapability.Mode = "Allow"
+			for _, rule := range effectiveCapability.Rules {
+				if rule.CapabilityMode == "Deny" {
+					effectiveCapability.Mode = "Deny"
+				}
+			}
+		}
+		effectiveCapabilities = append(effectiveCapabilities, effectiveCapability)
+	}
+	return effectiveCapabilities
+}
+
+// allowAll grants every capability because of source, rules recording what
+// granted it where there is one
+func allowAll(capabilities []string, source string, rules []PermissionRule) []EffectiveCapability {
+	effectiveCapabilities := []EffectiveCapability{}
+	for _, capability := range capabilities {
+		effectiveCapabilities = append(effectiveCapabilities, EffectiveCapability{
+			Name:   capability,
+			Mode:   "Allow",
+			Source: source,
+			Rules:  rules,
+		})
+	}
+	return effectiveCapabilities
+}
+
+// lockingProject returns the project whose permissions apply to content in
+// the first project of chain, which runs from the content's project up to
+// the top level, or nil when none is locked. A project locked including
+// nested projects controls everything beneath it, the highest one winning.
+func lockingProject(chain []Project, isProject bool) *Project {
+	var locked *Project
+	for i := range chain {
+		if chain[i].ContentPermissions == "LockedToProject" ||
+			(i == 0 && !isProject && chain[i].ContentPermissions == "LockedToProjectWithoutNested") {
+			locked = &chain[i]
+		}
+	}
+	return locked
+}
+
+// GetEffectivePermissions works out the capabilities a user has on a piece
+// of content, following site role, ownership, project leadership, project
+// locking and the precedence of the permission rules
+func (c *Client) GetEffectivePermissions(ctx context.Context, userID, contentType, contentID string) (*EffectivePermissions, error) {
+	content, ok := effectivePermissionsContentTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("unknown content type %s, expected one of %s", contentType, strings.Join(effectivePermissionsContentTypeNames(), ", "))
+	}
+
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(administratorSiteRoles, user.SiteRole) {
+		return &EffectivePermissions{Capabilities: allowAll(content.capabilities, EffectiveSourceSiteRole, []PermissionRule{})}, nil
+	}
+
+	ownerID, project, err := c.effectivePermissionsOwner(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == userID {
+		return &EffectivePermissions{Capabilities: allowAll(content.capabilities, EffectiveSourceOwner, []PermissionRule{})}, nil
+	}
+
+	chain, err := c.projectChain(ctx, *project)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := c.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	memberOf := map[string]bool{}
+	for _, group := range groups {
+		memberOf[group.ID] = true
+	}
+
+	// project leaders of the content's project, or any above it, have
+	// every capability
+	for _, project := range chain {
+		permissions, err := c.GetPermissions(ctx, "projects", project.ID)
+		if err != nil {
+			return nil, err
+		}
+		rules := permissionRules(permissions.GranteeCapabilities)
+		projectLeader := resolveEffectiveCapabilities([]string{"ProjectLeader"}, rules, userID, memberOf)[0]
+		if projectLeader.Mode == "Allow" {
+			return &EffectivePermissions{Capabilities: allowAll(content.capabilities, EffectiveSourceProjectLeader, projectLeader.Rules)}, nil
+		}
+	}
+
+	effectivePermissions := &EffectivePermissions{}
+	lockChain := chain
+	if contentType == "project" && len(chain) > 0 {
+		lockChain = chain[1:]
+	}
+
+	var permissions *ProjectPermissions
+	locked := lockingProject(lockChain, contentType == "project")
+	switch {
+	case locked == nil:
+		permissions, err = c.GetPermissions(ctx, content.permissionsType, contentID)
+	case contentType == "project":
+		effectivePermissions.LockedProjectID = locked.ID
+		permissions, err = c.GetPermissions(ctx, "projects", locked.ID)
+	default:
+		effectivePermissions.LockedProjectID = locked.ID
+		permissions, err = c.GetDefaultPermissions(ctx, locked.ID, content.defaultTarget)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rules := permissionRules(permissions.GranteeCapabilities)
+	effectivePermissions.Capabilities = resolveEffectiveCapabilities(content.capabilities, rules, userID, memberOf)
+	return effectivePermissions, nil
+}
+
+// effectivePermissionsOwner returns the owner of the content and the project
+// it sits in, a project being counted as sitting in itself
+func (c *Client) effectivePermissionsOwner(ctx context.Context, contentType, contentID string) (string, *Project, error) {
+	var ownerID, projectID string
+	switch contentType {
+	case "project":
+		project, err := c.getProjectByID(ctx, contentID)
+		if err != nil {
+			return "", nil, err
+		}
+		return project.Owner.ID, project, nil
+	case "workbook":
+		workbook, err := c.GetWorkbook(ctx, contentID)
+		if err != nil {
+			return "", nil, err
+		}
+		ownerID, projectID = workbook.Owner.ID, workbook.Project.ID
+	case "datasource":
+		datasource, err := c.GetDatasource(ctx, contentID, "")
+		if err != nil {
+			return "", nil, err
+		}
+		ownerID, projectID = datasource.Owner.ID, datasource.Project.ID
+	case "view":
+		view, err := c.GetView(ctx, contentID)
+		if err != nil {
+			return "", nil, err
+		}
+		ownerID, projectID = view.Owner.ID, view.Project.ID
+	case "virtual_connection":
+		virtualConnection, err := c.GetVirtualConnection(ctx, contentID)
+		if err != nil {
+			return "", nil, err
+		}
+		ownerID, projectID = virtualConnection.Owner.ID, virtualConnection.Project.ID
+	default:
+		return "", nil, fmt.Errorf("unknown content type %s", contentType)
+	}
+
+	project, err := c.getProjectByID(ctx, projectID)
+	if err != nil {
+		return "", nil, err
+	}
+	return ownerID, project, nil
+}
+
+func effectivePermissionsContentTypeNames() []string {
+	names := []string{}
+	for name := range effectivePermissionsContentTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,187 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &effectivePermissionsDataSource{}
+)
+
+func EffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+type effectivePermissionsDataSource struct {
+	client *Client
+}
+
+type effectivePermissionRuleModel struct {
+	UserID  types.String `tfsdk:"user_id"`
+	GroupID types.String `tfsdk:"group_id"`
+	Mode    types.String `tfsdk:"mode"`
+}
+
+type effectiveCapabilityModel struct {
+	Name   types.String                   `tfsdk:"name"`
+	Mode   types.String                   `tfsdk:"mode"`
+	Source types.String                   `tfsdk:"source"`
+	Rules  []effectivePermissionRuleModel `tfsdk:"rules"`
+}
+
+type effectivePermissionsDataSourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	UserID          types.String               `tfsdk:"user_id"`
+	ContentType     types.String               `tfsdk:"content_type"`
+	ContentID       types.String               `tfsdk:"content_id"`
+	LockedProjectID types.String               `tfsdk:"locked_project_id"`
+	Capabilities    []effectiveCapabilityModel `tfsdk:"capabilities"`
+	Site            types.String               `tfsdk:"site"`
+}
+
+func (d *effectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+func (d *effectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolve the capabilities a user effectively has on a piece of content, " +
+			"taking account of site role, ownership, project leadership, locked projects and group membership",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the result, in the form content_type/content_id/user_id",
+			},
+			"site": dataSourceSiteAttribute(),
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user",
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of content, one of " + strings.Join(effectivePermissionsContentTypeNames(), "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(effectivePermissionsContentTypeNames()...),
+				},
+			},
+			"content_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the content",
+			},
+			"locked_project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the locked project whose permissions apply in place of the content's own, empty when not locked",
+			},
+			"capabilities": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Every capability for the content type with the user's effective mode",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the capability",
+						},
+						"mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "Effective mode of the capability, Allow, Deny or None when no rule applies",
+						},
+						"source": schema.StringAttribute{
+							Computed: true,
+							Description: "What decided the mode, one of site_role/owner/project_leader/user/group/unspecified, " +
+								"rules for the user taking precedence over rules for their groups and Deny over Allow",
+						},
+						"rules": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Rules that decided the mode, for project_leader the rules making the user a project leader",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "ID of the user the rule is for",
+									},
+									"group_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "ID of the group the rule is for",
+									},
+									"mode": schema.StringAttribute{
+										Computed:    true,
+										Description: "Mode of the rule (Allow/Deny)",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *effectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state effectivePermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effectivePermissions, err := client.GetEffectivePermissions(ctx, state.UserID.ValueString(), state.ContentType.ValueString(), state.ContentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Effective Permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.ContentType.ValueString() + "/" + state.ContentID.ValueString() + "/" + state.UserID.ValueString())
+	state.LockedProjectID = types.StringValue(effectivePermissions.LockedProjectID)
+	state.Capabilities = []effectiveCapabilityModel{}
+	for _, capability := range effectivePermissions.Capabilities {
+		rules := []effectivePermissionRuleModel{}
+		for _, rule := range capability.Rules {
+			ruleModel := effectivePermissionRuleModel{
+				UserID:  types.StringNull(),
+				GroupID: types.StringNull(),
+				Mode:    types.StringValue(rule.CapabilityMode),
+			}
+			if rule.EntityType == "users" {
+				ruleModel.UserID = types.StringValue(rule.EntityID)
+			} else {
+				ruleModel.GroupID = types.StringValue(rule.EntityID)
+			}
+			rules = append(rules, ruleModel)
+		}
+		state.Capabilities = append(state.Capabilities, effectiveCapabilityModel{
+			Name:   types.StringValue(capability.Name),
+			Mode:   types.StringValue(capability.Mode),
+			Source: types.StringValue(capability.Source),
+			Rules:  rules,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *effectivePermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
@@ -0,0 +1,79 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEffectivePermissionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_effective_permissions"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_user" "test" {
+  name = "test_effective_permissions@test.test"
+  full_name = "test_effective_permissions@test.test"
+  email = "test_effective_permissions@test.test"
+  site_role = "Viewer"
+  auth_setting = "SAML"
+}
+resource "tableau_group" "test" {
+  name = "test_effective_permissions"
+  minimum_site_role = "Viewer"
+}
+resource "tableau_group_user" "test" {
+  group_id = tableau_group.test.id
+  user_id = tableau_user.test.id
+}
+resource "tableau_project_permissions" "test" {
+  project_id = tableau_project.test.id
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.test.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = tableau_user.test.id
+      capabilities = [
+        { name = "Write", mode = "Deny" },
+      ]
+    },
+  ]
+}
+data "tableau_effective_permissions" "test" {
+  user_id = tableau_user.test.id
+  content_type = "project"
+  content_id = tableau_project.test.id
+  depends_on = [tableau_project_permissions.test, tableau_group_user.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_effective_permissions.test", "capabilities.*", map[string]string{
+						"name":   "Read",
+						"mode":   "Allow",
+						"source": "group",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_effective_permissions.test", "capabilities.*", map[string]string{
+						"name":   "Write",
+						"mode":   "Deny",
+						"source": "user",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_effective_permissions.test", "capabilities.*", map[string]string{
+						"name":   "ProjectLeader",
+						"mode":   "None",
+						"source": "unspecified",
+					}),
+				),
+			},
+		},
+	})
+}
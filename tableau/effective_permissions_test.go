@@ -0,0 +1,66 @@
+package tableau
+
+import (
+	"testing"
+)
+
+func TestResolveEffectiveCapabilities(t *testing.T) {
+	rules := []PermissionRule{
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Read", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "contractors", CapabilityName: "Write", CapabilityMode: "Deny"},
+		{EntityType: "groups", EntityID: "contractors", CapabilityName: "Delete", CapabilityMode: "Deny"},
+		{EntityType: "users", EntityID: "user", CapabilityName: "Delete", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "others", CapabilityName: "ExportXml", CapabilityMode: "Allow"},
+		{EntityType: "users", EntityID: "someone-else", CapabilityName: "ExportXml", CapabilityMode: "Allow"},
+	}
+	memberOf := map[string]bool{"analysts": true, "contractors": true, "others": false}
+
+	effective := resolveEffectiveCapabilities([]string{"Read", "Write", "Delete", "ExportXml"}, rules, "user", memberOf)
+	expected := []struct {
+		mode, source string
+		rules        int
+	}{
+		{"Allow", EffectiveSourceGroup, 1},
+		// deny wins over allow between groups
+		{"Deny", EffectiveSourceGroup, 2},
+		// the user's own rule wins over their groups
+		{"Allow", EffectiveSourceUser, 1},
+		// rules for groups the user is not in, or other users, do not apply
+		{"None", EffectiveSourceUnspecified, 0},
+	}
+	for i, e := range expected {
+		if effective[i].Mode != e.mode || effective[i].Source != e.source || len(effective[i].Rules) != e.rules {
+			t.Errorf("%s: expected %s from %s with %d rules, got %s from %s with %v", effective[i].Name, e.mode, e.source, e.rules, effective[i].Mode, effective[i].Source, effective[i].Rules)
+		}
+	}
+}
+
+func TestLockingProject(t *testing.T) {
+	chain := []Project{
+		{ID: "nested", ContentPermissions: "ManagedByOwner"},
+		{ID: "parent", ContentPermissions: "LockedToProject"},
+		{ID: "top", ContentPermissions: "ManagedByOwner"},
+	}
+	if locked := lockingProject(chain, false); locked == nil || locked.ID != "parent" {
+		t.Errorf("expected content in a nested project to be locked by its parent, got %v", locked)
+	}
+
+	chain[1].ContentPermissions = "LockedToProjectWithoutNested"
+	if locked := lockingProject(chain, false); locked != nil {
+		t.Errorf("expected a parent locked without nested projects not to apply, got %v", locked)
+	}
+
+	chain[0].ContentPermissions = "LockedToProjectWithoutNested"
+	if locked := lockingProject(chain, false); locked == nil || locked.ID != "nested" {
+		t.Errorf("expected content to be locked by its own project, got %v", locked)
+	}
+	if locked := lockingProject(chain, true); locked != nil {
+		t.Errorf("expected a nested project not to be locked without nested projects, got %v", locked)
+	}
+
+	chain[2].ContentPermissions = "LockedToProject"
+	if locked := lockingProject(chain, false); locked == nil || locked.ID != "top" {
+		t.Errorf("expected the highest locked project to win, got %v", locked)
+	}
+}
@@ -0,0 +1,73 @@
+package tableau
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound is wrapped by lookups that searched successfully but found no
+// matching object, as opposed to the API itself responding with a 404
+var ErrNotFound = errors.New("not found")
+
+// APIError is a non-2xx response from the Tableau REST API, carrying the
+// error block from the response body, e.g.
+// {"error":{"summary":"Resource Not Found","detail":"Project '...' could not be found.","code":"404005"}}
+type APIError struct {
+	StatusCode int
+	Code       string
+	Summary    string
+	Detail     string
+	Body       string
+}
+
+type apiErrorResponse struct {
+	Error struct {
+		Summary string `json:"summary"`
+		Detail  string `json:"detail"`
+		Code    string `json:"code"`
+	} `json:"error"`
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiError := &APIError{
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+	errorResponse := apiErrorResponse{}
+	if err := json.Unmarshal(body, &errorResponse); err == nil {
+		apiError.Code = errorResponse.Error.Code
+		apiError.Summary = errorResponse.Error.Summary
+		apiError.Detail = errorResponse.Error.Detail
+	}
+	return apiError
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("status: %d, code: %s, %s: %s", e.StatusCode, e.Code, e.Summary, e.Detail)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err means the requested object does not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is a 409, e.g. a name already in use
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsPermissionDenied reports whether err is a 403, the signed in user lacking
+// the access needed for the request
+func IsPermissionDenied(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
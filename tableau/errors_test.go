@@ -0,0 +1,51 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"summary":"Resource Not Found","detail":"Project 'abc' could not be found.","code":"404005"}}`))
+	}))
+	defer server.Close()
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client()}
+
+	_, err := c.GetUser(context.Background(), "abc")
+	apiError, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected an APIError, got %T: %v", err, err)
+	}
+	if apiError.Code != "404005" || apiError.Summary != "Resource Not Found" || apiError.Detail != "Project 'abc' could not be found." {
+		t.Errorf("unexpected error details: %+v", apiError)
+	}
+	if !IsNotFound(err) || IsConflict(err) || IsPermissionDenied(err) {
+		t.Errorf("expected only IsNotFound to match %v", err)
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	cases := []struct {
+		err              error
+		notFound         bool
+		conflict         bool
+		permissionDenied bool
+	}{
+		{err: &APIError{StatusCode: http.StatusConflict}, conflict: true},
+		{err: &APIError{StatusCode: http.StatusForbidden}, permissionDenied: true},
+		{err: &APIError{StatusCode: http.StatusServiceUnavailable}},
+		{err: fmt.Errorf("Did not find project ID abc: %w", ErrNotFound), notFound: true},
+		{err: fmt.Errorf("page 2: %w", &APIError{StatusCode: http.StatusNotFound}), notFound: true},
+		{err: fmt.Errorf("dial tcp: connection refused")},
+	}
+	for _, c := range cases {
+		if IsNotFound(c.err) != c.notFound || IsConflict(c.err) != c.conflict || IsPermissionDenied(c.err) != c.permissionDenied {
+			t.Errorf("unexpected classification of %v", c.err)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package tableau
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &extractRefreshResource{}
+	_ resource.ResourceWithConfigure = &extractRefreshResource{}
+)
+
+func NewExtractRefreshResource() resource.Resource {
+	return &extractRefreshResource{}
+}
+
+type extractRefreshResource struct {
+	client *Client
+}
+
+type extractRefreshResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	WorkbookID   types.String   `tfsdk:"workbook_id"`
+	DatasourceID types.String   `tfsdk:"datasource_id"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	CompletedAt  types.String   `tfsdk:"completed_at"`
+	Site         types.String   `tfsdk:"site"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *extractRefreshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extract_refresh"
+}
+
+func (r *extractRefreshResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Refresh the extracts of a workbook or data source during apply, waiting for the refresh to finish. " +
+			"The refresh runs when the resource is created and again whenever triggers change, and destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the job that ran the refresh",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"workbook_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the workbook to refresh, exactly one of workbook_id and datasource_id is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("datasource_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datasource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the data source to refresh, exactly one of workbook_id and datasource_id is required",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that run the refresh again when any of them change",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the refresh finished",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *extractRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan extractRefreshResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var job *Job
+	var err error
+	if !plan.WorkbookID.IsNull() {
+		job, err = client.RefreshWorkbook(ctx, plan.WorkbookID.ValueString())
+	} else {
+		job, err = client.RefreshDatasource(ctx, plan.DatasourceID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Tableau Extract Refresh",
+			"Could not start extract refresh, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	jobID := job.ID
+	job, err = client.WaitForJob(ctx, jobID)
+	if err != nil {
+		detail := "Could not wait for extract refresh job ID " + jobID + ": " + err.Error()
+		if errors.Is(err, context.DeadlineExceeded) {
+			detail = "Extract refresh job ID " + jobID + " did not finish within the create timeout and is still running in Tableau, " +
+				"raise timeouts.create to wait for longer refreshes: " + err.Error()
+		}
+		resp.Diagnostics.AddError("Error Waiting For Tableau Extract Refresh", detail)
+		return
+	}
+	if !job.Succeeded() {
+		summary := "Tableau Extract Refresh Failed"
+		if job.FinishCode.String() == JobFinishCancelled {
+			summary = "Tableau Extract Refresh Cancelled"
+		}
+		notes := job.FailureNotes()
+		if len(notes) == 0 {
+			notes = []string{"no notes were reported for the job"}
+		}
+		for _, note := range notes {
+			resp.Diagnostics.AddError(summary, "Extract refresh job ID "+job.ID+": "+strings.TrimSpace(note))
+		}
+		return
+	}
+
+	plan.ID = types.StringValue(job.ID)
+	plan.CompletedAt = types.StringValue(job.CompletedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the state as it is, the refresh having happened once the
+// resource exists
+func (r *extractRefreshResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update only changes the timeouts, every other change running a new refresh
+func (r *extractRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan extractRefreshResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete leaves the refreshed extracts as they are
+func (r *extractRefreshResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *extractRefreshResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
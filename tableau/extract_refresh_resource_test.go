@@ -0,0 +1,64 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExtractRefreshResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_extract_refresh_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_extract_refresh_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+resource "tableau_extract_refresh" "test" {
+  datasource_id = tableau_datasource.test.id
+  triggers = {
+    load = "1"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh.test", "completed_at"),
+				),
+			},
+			// Changing triggers runs another refresh
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_extract_refresh_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_extract_refresh_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+resource "tableau_extract_refresh" "test" {
+  datasource_id = tableau_datasource.test.id
+  triggers = {
+    load = "2"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_extract_refresh.test", "triggers.load", "2"),
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// extract refresh types as set on a task, the server reporting existing tasks
+// by their task names
+const (
+	ExtractRefreshFull        = "FullRefresh"
+	ExtractRefreshIncremental = "IncrementalRefresh"
+)
+
+var extractRefreshTypes = []string{ExtractRefreshFull, ExtractRefreshIncremental}
+
+// cloudExtractRefreshTasksApiVersion is the first REST API version able to
+// create and update extract refresh tasks with their own schedule, as
+// Tableau Cloud has no server schedules to add content to
+const cloudExtractRefreshTasksApiVersion = "3.20"
+
+type ExtractRefreshTask struct {
+	ID                     string            `json:"id,omitempty"`
+	Priority               json.Number       `json:"priority,omitempty"`
+	ConsecutiveFailedCount json.Number       `json:"consecutiveFailedCount,omitempty"`
+	Type                   string            `json:"type,omitempty"`
+	Schedule               *Schedule         `json:"schedule,omitempty"`
+	Workbook               *ContentReference `json:"workbook,omitempty"`
+	Datasource             *ContentReference `json:"datasource,omitempty"`
+}
+
+// RefreshType returns the task's type as FullRefresh or IncrementalRefresh
+func (t ExtractRefreshTask) RefreshType() string {
+	switch t.Type {
+	case "IncrementExtractTask", ExtractRefreshIncremental:
+		return ExtractRefreshIncremental
+	}
+	return ExtractRefreshFull
+}
+
+type ExtractRefreshTaskItem struct {
+	ExtractRefresh ExtractRefreshTask `json:"extractRefresh"`
+}
+
+type ExtractRefreshTaskResponse struct {
+	Task ExtractRefreshTaskItem `json:"task"`
+}
+
+type ExtractRefreshTasksResponse struct {
+	Tasks struct {
+		Tasks []ExtractRefreshTaskItem `json:"task"`
+	} `json:"tasks"`
+}
+
+// CloudExtractRefresh is the task half of a request creating or updating an
+// extract refresh task with its own schedule
+type CloudExtractRefresh struct {
+	Type       string            `json:"type,omitempty"`
+	Workbook   *ContentReference `json:"workbook,omitempty"`
+	Datasource *ContentReference `json:"datasource,omitempty"`
+}
+
+type CloudExtractRefreshTaskRequest struct {
+	ExtractRefresh CloudExtractRefresh `json:"extractRefresh"`
+	Schedule       Schedule            `json:"schedule"`
+}
+
+type CloudExtractRefreshTaskResponse struct {
+	ExtractRefresh ExtractRefreshTask `json:"extractRefresh"`
+	Schedule       *Schedule          `json:"schedule,omitempty"`
+}
+
+// AddToScheduleRequest adds a workbook or data source to a server schedule
+type AddToScheduleRequest struct {
+	Task struct {
+		ExtractRefresh CloudExtractRefresh `json:"extractRefresh"`
+	} `json:"task"`
+}
+
+// GetExtractRefreshTasks lists every extract refresh task in the site, which
+// the REST API returns in a single response
+func (c *Client) GetExtractRefreshTasks(ctx context.Context) ([]ExtractRefreshTask, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tasks/extractRefreshes", c.ApiUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tasksResponse := ExtractRefreshTasksResponse{}
+	err = json.Unmarshal(body, &tasksResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []ExtractRefreshTask{}
+	for _, task := range tasksResponse.Tasks.Tasks {
+		tasks = append(tasks, task.ExtractRefresh)
+	}
+	return tasks, nil
+}
+
+func (c *Client) GetExtractRefreshTask(ctx context.Context, taskID string) (*ExtractRefreshTask, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tasks/extractRefreshes/%s", c.ApiUrl, taskID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResponse := ExtractRefreshTaskResponse{}
+	err = json.Unmarshal(body, &taskResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &taskResponse.Task.ExtractRefresh, nil
+}
+
+// CreateExtractRefreshTask creates a task with its own schedule, the Tableau
+// Cloud way of refreshing extracts
+func (c *Client) CreateExtractRefreshTask(ctx context.Context, task CloudExtractRefreshTaskRequest) (*ExtractRefreshTask, error) {
+	return c.sendExtractRefreshTask(ctx, fmt.Sprintf("%s/tasks/extractRefreshes", c.ApiUrl), task)
+}
+
+// UpdateExtractRefreshTask changes the type, target or schedule of a task
+// with its own schedule
+func (c *Client) UpdateExtractRefreshTask(ctx context.Context, taskID string, task CloudExtractRefreshTaskRequest) (*ExtractRefreshTask, error) {
+	return c.sendExtractRefreshTask(ctx, fmt.Sprintf("%s/tasks/extractRefreshes/%s", c.ApiUrl, taskID), task)
+}
+
+func (c *Client) sendExtractRefreshTask(ctx context.Context, endpoint string, task CloudExtractRefreshTaskRequest) (*ExtractRefreshTask, error) {
+	taskJson, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+
+	// both creating and updating are a POST
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(string(taskJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	taskResponse := CloudExtractRefreshTaskResponse{}
+	err = json.Unmarshal(body, &taskResponse)
+	if err != nil {
+		return nil, err
+	}
+	if taskResponse.ExtractRefresh.Schedule == nil {
+		taskResponse.ExtractRefresh.Schedule = taskResponse.Schedule
+	}
+	return &taskResponse.ExtractRefresh, nil
+}
+
+// AddToSchedule creates an extract refresh task for a workbook or data
+// source on a Tableau Server schedule. The response does not identify the
+// task created, so it is found afterwards in the site's tasks.
+func (c *Client) AddToSchedule(ctx context.Context, scheduleID string, target CloudExtractRefresh) (*ExtractRefreshTask, error) {
+	addRequest := AddToScheduleRequest{}
+	addRequest.Task.ExtractRefresh = CloudExtractRefresh{Workbook: target.Workbook, Datasource: target.Datasource}
+
+	addJson, err := json.Marshal(addRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := "datasources"
+	if target.Workbook != nil {
+		contentType = "workbooks"
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/schedules/%s/%s", c.ApiUrl, scheduleID, contentType), strings.NewReader(string(addJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := c.GetExtractRefreshTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID := extractRefreshTargetID(ExtractRefreshTask{Workbook: target.Workbook, Datasource: target.Datasource})
+	for _, task := range tasks {
+		if task.Schedule != nil && task.Schedule.ID == scheduleID && extractRefreshTargetID(task) == targetID {
+			return &task, nil
+		}
+	}
+	return nil, fmt.Errorf("Did not find the extract refresh task for %s in schedule ID %s: %w", targetID, scheduleID, ErrNotFound)
+}
+
+func (c *Client) DeleteExtractRefreshTask(ctx context.Context, taskID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tasks/extractRefreshes/%s", c.ApiUrl, taskID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// extractRefreshTargetID returns the ID of the workbook or data source a
+// task refreshes
+func extractRefreshTargetID(task ExtractRefreshTask) string {
+	if task.Workbook != nil {
+		return task.Workbook.ID
+	}
+	if task.Datasource != nil {
+		return task.Datasource.ID
+	}
+	return ""
+}
@@ -0,0 +1,384 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &extractRefreshTaskResource{}
+	_ resource.ResourceWithConfigure      = &extractRefreshTaskResource{}
+	_ resource.ResourceWithImportState    = &extractRefreshTaskResource{}
+	_ resource.ResourceWithValidateConfig = &extractRefreshTaskResource{}
+	_ resource.ResourceWithModifyPlan     = &extractRefreshTaskResource{}
+)
+
+func NewExtractRefreshTaskResource() resource.Resource {
+	return &extractRefreshTaskResource{}
+}
+
+type extractRefreshTaskResource struct {
+	client *Client
+}
+
+type extractRefreshTaskResourceModel struct {
+	ID           types.String            `tfsdk:"id"`
+	WorkbookID   types.String            `tfsdk:"workbook_id"`
+	DatasourceID types.String            `tfsdk:"datasource_id"`
+	Type         types.String            `tfsdk:"type"`
+	ScheduleID   types.String            `tfsdk:"schedule_id"`
+	Schedule     *scheduleFrequencyModel `tfsdk:"schedule"`
+	Priority     types.Int64             `tfsdk:"priority"`
+	NextRunAt    types.String            `tfsdk:"next_run_at"`
+	Site         types.String            `tfsdk:"site"`
+}
+
+func (r *extractRefreshTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extract_refresh_task"
+}
+
+func (r *extractRefreshTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a task refreshing the extracts of a workbook or data source, either on a Tableau Server schedule " +
+			"or, as on Tableau Cloud, with a schedule of its own",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the extract refresh task",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"workbook_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the workbook to refresh, exactly one of workbook_id and datasource_id is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("datasource_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datasource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the data source to refresh, exactly one of workbook_id and datasource_id is required",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the task runs a FullRefresh or an IncrementalRefresh, defaults to FullRefresh, incremental refreshes needing a task with its own schedule",
+				Default:     stringdefault.StaticString(ExtractRefreshFull),
+				Validators: []validator.String{
+					stringvalidator.OneOf(extractRefreshTypes...),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the Tableau Server schedule to run the task on, exactly one of schedule_id and schedule is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("schedule")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Schedule of the task's own, as Tableau Cloud uses in place of server schedules, exactly one of schedule_id and schedule is required. " +
+					"Needs REST API version " + cloudExtractRefreshTasksApiVersion + " or later.",
+				Attributes: scheduleFrequencyAttributes(),
+			},
+			"priority": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Priority of the task",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the task next runs",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the schedule's frequency details and that an
+// incremental refresh has a schedule of its own at plan time
+func (r *extractRefreshTaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var refreshType, scheduleID types.String
+	var schedule types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &refreshType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule_id"), &scheduleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if refreshType.ValueString() == ExtractRefreshIncremental && !scheduleID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Extract Refresh Type",
+			"Tasks added to a Tableau Server schedule are full refreshes, an IncrementalRefresh needs a task with its own schedule",
+		)
+	}
+
+	if schedule.IsNull() || schedule.IsUnknown() {
+		return
+	}
+	var frequency scheduleFrequencyModel
+	resp.Diagnostics.Append(schedule.As(ctx, &frequency, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(frequency.validate(ctx, path.Root("schedule").AtName("frequency"))...)
+}
+
+// ModifyPlan warns when a task with its own schedule is planned against a
+// server too old to create one
+func (r *extractRefreshTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan extractRefreshTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ScheduleID.IsNull() {
+		return
+	}
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Extract refresh tasks with their own schedule", cloudExtractRefreshTasksApiVersion)...)
+}
+
+func (r *extractRefreshTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan extractRefreshTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var task *ExtractRefreshTask
+	var err error
+	if !plan.ScheduleID.IsNull() {
+		task, err = client.AddToSchedule(ctx, plan.ScheduleID.ValueString(), extractRefreshTarget(plan))
+	} else {
+		taskRequest, diags := extractRefreshTaskRequest(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		task, err = client.CreateExtractRefreshTask(ctx, taskRequest)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating extract refresh task",
+			"Could not create extract refresh task, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(task.ID)
+	plan.setComputed(task)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *extractRefreshTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state extractRefreshTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := client.GetExtractRefreshTask(ctx, state.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Extract Refresh Task",
+			"Could not read Tableau extract refresh task ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(task.ID)
+	state.WorkbookID, state.DatasourceID = types.StringNull(), types.StringNull()
+	if task.Workbook != nil {
+		state.WorkbookID = types.StringValue(task.Workbook.ID)
+	}
+	if task.Datasource != nil {
+		state.DatasourceID = types.StringValue(task.Datasource.ID)
+	}
+	state.Type = types.StringValue(task.RefreshType())
+
+	state.ScheduleID, state.Schedule, diags = taskSchedule(ctx, task.Schedule, state.ScheduleID, state.Schedule)
+	resp.Diagnostics.Append(diags...)
+	state.setComputed(task)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *extractRefreshTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan extractRefreshTaskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// everything about a task on a server schedule replaces it, so only a
+	// task with its own schedule is updated
+	var task *ExtractRefreshTask
+	var err error
+	if plan.ScheduleID.IsNull() {
+		taskRequest, diags := extractRefreshTaskRequest(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		task, err = client.UpdateExtractRefreshTask(ctx, plan.ID.ValueString(), taskRequest)
+	} else {
+		task, err = client.GetExtractRefreshTask(ctx, plan.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Extract Refresh Task",
+			"Could not update extract refresh task, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.setComputed(task)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *extractRefreshTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state extractRefreshTaskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteExtractRefreshTask(ctx, state.ID.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Extract Refresh Task",
+			"Could not delete extract refresh task, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *extractRefreshTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *extractRefreshTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
+
+// setComputed sets the attributes only ever read from the server
+func (m *extractRefreshTaskResourceModel) setComputed(task *ExtractRefreshTask) {
+	m.Priority = jsonNumberInt64(task.Priority)
+	m.NextRunAt = types.StringNull()
+	if task.Schedule != nil {
+		m.NextRunAt = types.StringValue(task.Schedule.NextRunAt)
+	}
+}
+
+func extractRefreshTarget(model extractRefreshTaskResourceModel) CloudExtractRefresh {
+	target := CloudExtractRefresh{}
+	if !model.WorkbookID.IsNull() {
+		target.Workbook = &ContentReference{ID: model.WorkbookID.ValueString()}
+	} else {
+		target.Datasource = &ContentReference{ID: model.DatasourceID.ValueString()}
+	}
+	return target
+}
+
+func extractRefreshTaskRequest(ctx context.Context, model extractRefreshTaskResourceModel) (CloudExtractRefreshTaskRequest, diag.Diagnostics) {
+	schedule, diags := model.Schedule.schedule(ctx)
+	extractRefresh := extractRefreshTarget(model)
+	extractRefresh.Type = model.Type.ValueString()
+	return CloudExtractRefreshTaskRequest{
+		ExtractRefresh: extractRefresh,
+		Schedule:       schedule,
+	}, diags
+}
+
+// jsonNumberInt64 returns a number the server may send as a string, null
+// when it is missing
+func jsonNumberInt64(number json.Number) types.Int64 {
+	value, err := number.Int64()
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
@@ -0,0 +1,79 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExtractRefreshTaskResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_extract_refresh_task_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_extract_refresh_task_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+resource "tableau_extract_refresh_task" "test" {
+  datasource_id = tableau_datasource.test.id
+  schedule = {
+    frequency = "Daily"
+    start_time = "06:00:00"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh_task.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh_task.test", "priority"),
+					resource.TestCheckResourceAttrSet("tableau_extract_refresh_task.test", "next_run_at"),
+					resource.TestCheckResourceAttr("tableau_extract_refresh_task.test", "type", "FullRefresh"),
+					resource.TestCheckResourceAttr("tableau_extract_refresh_task.test", "schedule.frequency", "Daily"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_extract_refresh_task.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"next_run_at"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_extract_refresh_task_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_extract_refresh_task_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+resource "tableau_extract_refresh_task" "test" {
+  datasource_id = tableau_datasource.test.id
+  type = "IncrementalRefresh"
+  schedule = {
+    frequency = "Weekly"
+    start_time = "07:00:00"
+    week_days = ["Monday", "Friday"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_extract_refresh_task.test", "type", "IncrementalRefresh"),
+					resource.TestCheckResourceAttr("tableau_extract_refresh_task.test", "schedule.frequency", "Weekly"),
+					resource.TestCheckResourceAttr("tableau_extract_refresh_task.test", "schedule.week_days.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
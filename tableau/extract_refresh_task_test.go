@@ -0,0 +1,55 @@
+package tableau
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExtractRefreshTaskRefreshType(t *testing.T) {
+	cases := map[string]string{
+		"RefreshExtractTask":   ExtractRefreshFull,
+		"FullRefresh":          ExtractRefreshFull,
+		"IncrementExtractTask": ExtractRefreshIncremental,
+		"IncrementalRefresh":   ExtractRefreshIncremental,
+		"":                     ExtractRefreshFull,
+	}
+	for taskType, expected := range cases {
+		if refreshType := (ExtractRefreshTask{Type: taskType}).RefreshType(); refreshType != expected {
+			t.Errorf("expected %s for task type %q, got %s", expected, taskType, refreshType)
+		}
+	}
+}
+
+func TestExtractRefreshTasksResponse(t *testing.T) {
+	body := `{"tasks": {"task": [
+		{"extractRefresh": {"id": "1", "priority": 50, "consecutiveFailedCount": "2", "type": "RefreshExtractTask",
+			"schedule": {"id": "s1", "name": "Weekly", "nextRunAt": "2024-01-01T06:00:00Z"}, "workbook": {"id": "w1"}}},
+		{"extractRefresh": {"id": "2", "priority": "30", "type": "IncrementalRefresh",
+			"schedule": {"frequency": "Daily", "frequencyDetails": {"start": "06:00:00"}}, "datasource": {"id": "d1"}}}
+	]}}`
+	tasksResponse := ExtractRefreshTasksResponse{}
+	if err := json.Unmarshal([]byte(body), &tasksResponse); err != nil {
+		t.Fatalf("unexpected error decoding tasks: %s", err)
+	}
+	tasks := tasksResponse.Tasks.Tasks
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+
+	server, cloud := tasks[0].ExtractRefresh, tasks[1].ExtractRefresh
+	if extractRefreshTargetID(server) != "w1" || server.Schedule.ID != "s1" {
+		t.Errorf("expected workbook w1 on schedule s1, got %+v", server)
+	}
+	if count := jsonNumberInt64(server.ConsecutiveFailedCount); count.ValueInt64() != 2 {
+		t.Errorf("expected 2 consecutive failures, got %s", count)
+	}
+	if extractRefreshTargetID(cloud) != "d1" || cloud.Schedule.ID != "" || cloud.Schedule.FrequencyDetails.Start != "06:00:00" {
+		t.Errorf("expected data source d1 with its own schedule, got %+v", cloud)
+	}
+	if priority := jsonNumberInt64(cloud.Priority); priority.ValueInt64() != 30 {
+		t.Errorf("expected priority 30, got %s", priority)
+	}
+	if priority := jsonNumberInt64(""); !priority.IsNull() {
+		t.Errorf("expected a missing priority to be null, got %s", priority)
+	}
+}
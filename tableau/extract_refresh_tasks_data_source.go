@@ -0,0 +1,198 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &extractRefreshTasksDataSource{}
+	_ datasource.DataSourceWithConfigure = &extractRefreshTasksDataSource{}
+)
+
+func ExtractRefreshTasksDataSource() datasource.DataSource {
+	return &extractRefreshTasksDataSource{}
+}
+
+type extractRefreshTasksDataSource struct {
+	client *Client
+}
+
+type extractRefreshTasksNestedDataModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Type                   types.String `tfsdk:"type"`
+	Priority               types.Int64  `tfsdk:"priority"`
+	ConsecutiveFailedCount types.Int64  `tfsdk:"consecutive_failed_count"`
+	WorkbookID             types.String `tfsdk:"workbook_id"`
+	DatasourceID           types.String `tfsdk:"datasource_id"`
+	ScheduleID             types.String `tfsdk:"schedule_id"`
+	NextRunAt              types.String `tfsdk:"next_run_at"`
+	scheduleFrequencyModel
+}
+
+type extractRefreshTasksDataSourceModel struct {
+	ID    types.String                         `tfsdk:"id"`
+	Tasks []extractRefreshTasksNestedDataModel `tfsdk:"tasks"`
+	Site  types.String                         `tfsdk:"site"`
+}
+
+func (d *extractRefreshTasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extract_refresh_tasks"
+}
+
+func (d *extractRefreshTasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the extract refresh tasks of a site",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the list of extract refresh tasks",
+			},
+			"site": dataSourceSiteAttribute(),
+			"tasks": schema.ListNestedAttribute{
+				Description: "List of extract refresh tasks and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Task ID",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Whether the task runs a FullRefresh or an IncrementalRefresh",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the task",
+							Computed:    true,
+						},
+						"consecutive_failed_count": schema.Int64Attribute{
+							Description: "Number of times in a row the task has failed",
+							Computed:    true,
+						},
+						"workbook_id": schema.StringAttribute{
+							Description: "ID of the workbook refreshed, null when the task refreshes a data source",
+							Computed:    true,
+						},
+						"datasource_id": schema.StringAttribute{
+							Description: "ID of the data source refreshed, null when the task refreshes a workbook",
+							Computed:    true,
+						},
+						"schedule_id": schema.StringAttribute{
+							Description: "ID of the Tableau Server schedule the task runs on, null when the task has a schedule of its own",
+							Computed:    true,
+						},
+						"next_run_at": schema.StringAttribute{
+							Description: "When the task next runs",
+							Computed:    true,
+						},
+						"frequency": schema.StringAttribute{
+							Description: "How often the task runs",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time of day the task runs or starts running",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time of day the task stops running",
+							Computed:    true,
+						},
+						"interval_hours": schema.Int64Attribute{
+							Description: "Hours between runs",
+							Computed:    true,
+						},
+						"interval_minutes": schema.Int64Attribute{
+							Description: "Minutes between runs",
+							Computed:    true,
+						},
+						"week_days": schema.SetAttribute{
+							Description: "Days of the week the task runs on, null when it runs every day",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"month_days": schema.SetAttribute{
+							Description: "Days of the month the task runs on",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *extractRefreshTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state extractRefreshTasksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks, err := client.GetExtractRefreshTasks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Extract Refresh Tasks",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Tasks = []extractRefreshTasksNestedDataModel{}
+	for _, task := range tasks {
+		taskModel := extractRefreshTasksNestedDataModel{
+			ID:                     types.StringValue(task.ID),
+			Type:                   types.StringValue(task.RefreshType()),
+			Priority:               jsonNumberInt64(task.Priority),
+			ConsecutiveFailedCount: jsonNumberInt64(task.ConsecutiveFailedCount),
+			WorkbookID:             types.StringNull(),
+			DatasourceID:           types.StringNull(),
+			ScheduleID:             types.StringNull(),
+			NextRunAt:              types.StringNull(),
+		}
+		if task.Workbook != nil {
+			taskModel.WorkbookID = types.StringValue(task.Workbook.ID)
+		}
+		if task.Datasource != nil {
+			taskModel.DatasourceID = types.StringValue(task.Datasource.ID)
+		}
+		schedule := &Schedule{}
+		if task.Schedule != nil {
+			schedule = task.Schedule
+			taskModel.NextRunAt = types.StringValue(schedule.NextRunAt)
+		}
+		// tasks with their own schedule have no schedule ID
+		if schedule.ID != "" {
+			taskModel.ScheduleID = types.StringValue(schedule.ID)
+		}
+		resp.Diagnostics.Append(taskModel.setFrequency(ctx, schedule)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Tasks = append(state.Tasks, taskModel)
+	}
+
+	state.ID = types.StringValue("allExtractRefreshTasks")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *extractRefreshTasksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
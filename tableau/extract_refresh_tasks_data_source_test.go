@@ -0,0 +1,47 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExtractRefreshTasksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_extract_refresh_tasks_data_source"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_datasource" "test" {
+  name = "test_extract_refresh_tasks_data_source"
+  project_id = tableau_project.test.id
+  file_path = "testdata/datasource.tds"
+}
+resource "tableau_extract_refresh_task" "test" {
+  datasource_id = tableau_datasource.test.id
+  schedule = {
+    frequency = "Monthly"
+    start_time = "05:00:00"
+    month_days = ["1"]
+  }
+}
+data "tableau_extract_refresh_tasks" "test" {
+  depends_on = [tableau_extract_refresh_task.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_extract_refresh_tasks.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_extract_refresh_tasks.test", "tasks.*", map[string]string{
+						"type":         "FullRefresh",
+						"frequency":    "Monthly",
+						"month_days.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,224 @@
+package tableau
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strconv"
+)
+
+const (
+	megabyte = 1024 * 1024
+	// defaultUploadChunkSize is a whole number of megabytes so the size
+	// Tableau reports after each chunk can be checked exactly
+	defaultUploadChunkSize = 16 * megabyte
+	// maxUploadAttempts is how many times an upload is resumed, or started
+	// again in a new session once one is corrupt, before giving up
+	maxUploadAttempts = 3
+)
+
+// errUploadSessionCorrupt is returned when an upload session holds more than
+// was sent, so it cannot be resumed
+var errUploadSessionCorrupt = errors.New("upload session is corrupt")
+
+type FileUpload struct {
+	UploadSessionID string `json:"uploadSessionId"`
+	// FileSize is the size uploaded so far, in megabytes
+	FileSize string `json:"fileSize"`
+}
+
+type FileUploadResponse struct {
+	FileUpload FileUpload `json:"fileUpload"`
+}
+
+// UploadError is returned when a file upload fails part way through,
+// recording how much of the file had been uploaded
+type UploadError struct {
+	FilePath        string
+	UploadSessionID string
+	Uploaded        int64
+	Size            int64
+	Err             error
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("error uploading %s in session %s after %d of %d bytes: %s", e.FilePath, e.UploadSessionID, e.Uploaded, e.Size, e.Err)
+}
+
+func (e *UploadError) Unwrap() error {
+	return e.Err
+}
+
+func (c *Client) chunkSize() int64 {
+	if c.uploadChunkSize > 0 {
+		return c.uploadChunkSize
+	}
+	return defaultUploadChunkSize
+}
+
+// InitiateFileUpload starts an upload session, returning its ID
+func (c *Client) InitiateFileUpload(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/fileUploads", c.ApiUrl), nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	fileUploadResponse := FileUploadResponse{}
+	err = json.Unmarshal(body, &fileUploadResponse)
+	if err != nil {
+		return "", err
+	}
+
+	return fileUploadResponse.FileUpload.UploadSessionID, nil
+}
+
+// AppendToFileUpload sends size bytes of file starting at offset as the next
+// chunk of the upload session, streaming them from disk rather than holding
+// the chunk in memory
+func (c *Client) AppendToFileUpload(ctx context.Context, uploadSessionID string, file io.ReaderAt, offset, size int64) (*FileUpload, error) {
+	multipartBody := &bytes.Buffer{}
+	writer := multipart.NewWriter(multipartBody)
+	_, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`name="request_payload"`},
+		"Content-Type":        {"application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`name="tableau_file"; filename="file"`},
+		"Content-Type":        {"application/octet-stream"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the chunk itself is streamed between the part headers and the closing
+	// boundary
+	prefixBytes := bytes.Clone(multipartBody.Bytes())
+	multipartBody.Reset()
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	suffixBytes := multipartBody.Bytes()
+
+	getBody := func() (io.ReadCloser, error) {
+		return io.NopCloser(io.MultiReader(
+			bytes.NewReader(prefixBytes),
+			io.NewSectionReader(file, offset, size),
+			bytes.NewReader(suffixBytes),
+		)), nil
+	}
+	body, _ := getBody()
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/fileUploads/%s", c.ApiUrl, uploadSessionID), body)
+	if err != nil {
+		return nil, err
+	}
+	req.GetBody = getBody
+	req.ContentLength = int64(len(prefixBytes)) + size + int64(len(suffixBytes))
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+
+	responseBody, err := c.doRequest(retryableUploadChunk(fileTransfer(req)))
+	if err != nil {
+		return nil, err
+	}
+
+	fileUploadResponse := FileUploadResponse{}
+	err = json.Unmarshal(responseBody, &fileUploadResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileUploadResponse.FileUpload, nil
+}
+
+// UploadFile uploads a file in chunks, returning the ID of the upload session
+// to commit it with. A chunk failing part way through is retried, and should
+// it still fail the upload is resumed in the same session after the last
+// chunk Tableau confirmed. Only a session left holding more than was sent is
+// abandoned, the whole file then being uploaded again in a new one.
+func (c *Client) UploadFile(ctx context.Context, filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	uploadSessionID, offset := "", int64(0)
+	for attempt := 1; ; attempt++ {
+		if uploadSessionID == "" {
+			uploadSessionID, err = c.InitiateFileUpload(ctx)
+			if err != nil {
+				return "", err
+			}
+			offset = 0
+		}
+		err := c.uploadChunks(ctx, filePath, file, fileInfo.Size(), uploadSessionID, offset)
+		if err == nil {
+			return uploadSessionID, nil
+		}
+		if ctx.Err() != nil || attempt >= maxUploadAttempts {
+			return "", err
+		}
+
+		var uploadErr *UploadError
+		if errors.As(err, &uploadErr) && !errors.Is(err, errUploadSessionCorrupt) {
+			offset = uploadErr.Uploaded
+		} else {
+			uploadSessionID = ""
+		}
+	}
+}
+
+// uploadChunks appends the file from offset onwards to the upload session
+func (c *Client) uploadChunks(ctx context.Context, filePath string, file io.ReaderAt, fileSize int64, uploadSessionID string, offset int64) error {
+	chunkSize := c.chunkSize()
+	for ; offset < fileSize; offset += chunkSize {
+		size := min(chunkSize, fileSize-offset)
+		fileUpload, err := c.AppendToFileUpload(ctx, uploadSessionID, file, offset, size)
+		if err == nil {
+			err = checkUploadedSize(fileUpload, offset+size)
+		}
+		if err != nil {
+			return &UploadError{
+				FilePath:        filePath,
+				UploadSessionID: uploadSessionID,
+				Uploaded:        offset,
+				Size:            fileSize,
+				Err:             err,
+			}
+		}
+	}
+	return nil
+}
+
+// checkUploadedSize catches a retried chunk that Tableau had in fact already
+// appended, which would leave the session holding more than was sent
+func checkUploadedSize(fileUpload *FileUpload, uploaded int64) error {
+	reportedSize, err := strconv.ParseInt(fileUpload.FileSize, 10, 64)
+	if err != nil {
+		// nothing to check against
+		return nil
+	}
+	if maxSize := (uploaded + megabyte - 1) / megabyte; reportedSize > maxSize {
+		return fmt.Errorf("%w, it holds %d MB but only %d bytes were sent", errUploadSessionCorrupt, reportedSize, uploaded)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package tableau
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fileUploadServer stubs the upload session endpoints, keeping what has been
+// appended to each session
+type fileUploadServer struct {
+	mu       sync.Mutex
+	sessions map[string]*bytes.Buffer
+	puts     int
+	// failPut makes the given PUT, counting from 1, and any up to failUntil
+	// fail with a 503, after appending its chunk when appendFailedPut is set
+	failPut         int
+	failUntil       int
+	appendFailedPut bool
+	// putDelay holds up each PUT, as a large chunk takes time to send
+	putDelay  time.Duration
+	committed string
+}
+
+func (s *fileUploadServer) failing() bool {
+	return s.puts == s.failPut || (s.puts > s.failPut && s.puts <= s.failUntil)
+}
+
+func newFileUploadServer(t *testing.T, s *fileUploadServer) *httptest.Server {
+	s.sessions = map[string]*bytes.Buffer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/fileUploads":
+			uploadSessionID := fmt.Sprintf("session-%d", len(s.sessions)+1)
+			s.sessions[uploadSessionID] = &bytes.Buffer{}
+			fmt.Fprintf(w, `{"fileUpload":{"uploadSessionId":"%s","fileSize":"0"}}`, uploadSessionID)
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/fileUploads/"):
+			s.puts++
+			time.Sleep(s.putDelay)
+			uploadSessionID := strings.TrimPrefix(r.URL.Path, "/fileUploads/")
+			chunk := readFilePart(t, r, "tableau_file")
+			if s.failing() && !s.appendFailedPut {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			s.sessions[uploadSessionID].Write(chunk)
+			if s.failing() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			uploadedSize := (s.sessions[uploadSessionID].Len() + megabyte - 1) / megabyte
+			fmt.Fprintf(w, `{"fileUpload":{"uploadSessionId":"%s","fileSize":"%d"}}`, uploadSessionID, uploadedSize)
+		case r.Method == "POST" && r.URL.Path == "/workbooks":
+			s.committed = r.URL.Query().Get("uploadSessionId")
+			if chunk := readFilePart(t, r, "tableau_workbook"); chunk != nil {
+				t.Errorf("expected no file to be sent when committing an upload session")
+			}
+			w.Write([]byte(`{"workbook":{"id":"workbook-luid"}}`))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func readFilePart(t *testing.T, r *http.Request, partName string) []byte {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		t.Errorf("unexpected content type: %s", err)
+		return nil
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			t.Errorf("unexpected error reading part: %s", err)
+			return nil
+		}
+		if strings.HasPrefix(part.Header.Get("Content-Disposition"), fmt.Sprintf(`name="%s"`, partName)) {
+			content, _ := io.ReadAll(part)
+			return content
+		}
+	}
+}
+
+func writeUploadFile(t *testing.T, size int) (string, []byte) {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	filePath := filepath.Join(t.TempDir(), "extract.hyper")
+	if err := os.WriteFile(filePath, content, 0o600); err != nil {
+		t.Fatalf("unable to write file: %s", err)
+	}
+	return filePath, content
+}
+
+func testUploadClient(server *httptest.Server) *Client {
+	c := testRetryClient(server)
+	c.uploadChunkSize = megabyte
+	return c
+}
+
+func TestUploadFileRetriesFailedChunk(t *testing.T) {
+	s := &fileUploadServer{failPut: 2}
+	server := newFileUploadServer(t, s)
+	filePath, content := writeUploadFile(t, 2*megabyte+megabyte/2)
+
+	uploadSessionID, err := testUploadClient(server).UploadFile(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.puts != 4 {
+		t.Errorf("expected 3 chunks and 1 retry, got %d PUTs", s.puts)
+	}
+	if !bytes.Equal(s.sessions[uploadSessionID].Bytes(), content) {
+		t.Errorf("uploaded content does not match the file")
+	}
+}
+
+func TestUploadFileResumesSessionAfterChunkRetriesRunOut(t *testing.T) {
+	// the second chunk fails on every one of its 3 attempts
+	s := &fileUploadServer{failPut: 2, failUntil: 4}
+	server := newFileUploadServer(t, s)
+	filePath, content := writeUploadFile(t, 2*megabyte+megabyte/2)
+
+	uploadSessionID, err := testUploadClient(server).UploadFile(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if uploadSessionID != "session-1" || len(s.sessions) != 1 {
+		t.Errorf("expected the upload to resume in session-1, got %s of %d sessions", uploadSessionID, len(s.sessions))
+	}
+	if s.puts != 6 {
+		t.Errorf("expected the first chunk to be sent once and the rest after resuming, got %d PUTs", s.puts)
+	}
+	if !bytes.Equal(s.sessions[uploadSessionID].Bytes(), content) {
+		t.Errorf("uploaded content does not match the file")
+	}
+}
+
+func TestUploadFileIgnoresRequestTimeout(t *testing.T) {
+	s := &fileUploadServer{putDelay: 100 * time.Millisecond}
+	server := newFileUploadServer(t, s)
+	filePath, content := writeUploadFile(t, megabyte)
+
+	c := testUploadClient(server)
+	c.HTTPClient.Timeout = 20 * time.Millisecond
+	uploadSessionID, err := c.UploadFile(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("expected a chunk slower than the request timeout to upload, got %s", err)
+	}
+	if !bytes.Equal(s.sessions[uploadSessionID].Bytes(), content) {
+		t.Errorf("uploaded content does not match the file")
+	}
+
+	// the context still bounds the upload
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.UploadFile(ctx, filePath); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the upload to stop at the context deadline, got %v", err)
+	}
+}
+
+func TestUploadFileStartsNewSessionWhenChunkAppendedTwice(t *testing.T) {
+	s := &fileUploadServer{failPut: 2, appendFailedPut: true}
+	server := newFileUploadServer(t, s)
+	filePath, content := writeUploadFile(t, 2*megabyte+megabyte/2)
+
+	uploadSessionID, err := testUploadClient(server).UploadFile(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if uploadSessionID != "session-2" {
+		t.Errorf("expected the corrupt session to be abandoned, got %s", uploadSessionID)
+	}
+	if !bytes.Equal(s.sessions[uploadSessionID].Bytes(), content) {
+		t.Errorf("uploaded content does not match the file")
+	}
+}
+
+func TestUploadFileReportsProgressOnFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.Write([]byte(`{"fileUpload":{"uploadSessionId":"session","fileSize":"0"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(server.Close)
+	filePath, _ := writeUploadFile(t, megabyte)
+
+	_, err := testUploadClient(server).UploadFile(context.Background(), filePath)
+	var uploadErr *UploadError
+	if !errors.As(err, &uploadErr) {
+		t.Fatalf("expected an UploadError, got %v", err)
+	}
+	if uploadErr.Uploaded != 0 || uploadErr.Size != megabyte {
+		t.Errorf("unexpected upload progress: %+v", uploadErr)
+	}
+}
+
+func TestPublishWorkbookCommitsLargeFilesFromUploadSession(t *testing.T) {
+	s := &fileUploadServer{}
+	server := newFileUploadServer(t, s)
+
+	// a sparse file just over the single request limit
+	filePath := filepath.Join(t.TempDir(), "large.twbx")
+	file, err := os.Create(filePath)
+	if err != nil {
+		t.Fatalf("unable to create file: %s", err)
+	}
+	file.Close()
+	if err := os.Truncate(filePath, maxSinglePublishSize+1); err != nil {
+		t.Fatalf("unable to size file: %s", err)
+	}
+
+	c := testRetryClient(server)
+	c.uploadChunkSize = 32 * megabyte
+	workbook, err := c.PublishWorkbook(context.Background(), filePath, PublishWorkbook{Name: "large"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workbook.ID != "workbook-luid" || s.committed != "session-1" {
+		t.Errorf("expected the upload session to be committed, got %q", s.committed)
+	}
+	if s.puts != 3 || s.sessions["session-1"].Len() != maxSinglePublishSize+1 {
+		t.Errorf("expected the file to be uploaded in 3 chunks, got %d", s.puts)
+	}
+}
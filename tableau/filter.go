@@ -0,0 +1,98 @@
+package tableau
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type FilterOperator string
+
+const (
+	FilterEquals              FilterOperator = "eq"
+	FilterEqualsIgnoreCase    FilterOperator = "cieq"
+	FilterGreaterThan         FilterOperator = "gt"
+	FilterGreaterThanOrEquals FilterOperator = "gte"
+	FilterLessThan            FilterOperator = "lt"
+	FilterLessThanOrEquals    FilterOperator = "lte"
+	FilterHas                 FilterOperator = "has"
+	FilterIn                  FilterOperator = "in"
+)
+
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// ListQuery builds the filter, sort and fields query parameters accepted by
+// the Tableau list endpoints, e.g. ?filter=name:eq:Finance&sort=name:asc
+type ListQuery struct {
+	filters []string
+	sorts   []string
+	fields  []string
+}
+
+func NewListQuery() *ListQuery {
+	return &ListQuery{}
+}
+
+func (q *ListQuery) Filter(field string, operator FilterOperator, value string) *ListQuery {
+	q.filters = append(q.filters, fmt.Sprintf("%s:%s:%s", field, operator, value))
+	return q
+}
+
+// FilterEqualsOrScan filters field to equal value, unless value holds a comma
+// or colon, which the filter syntax has no way to escape. The query is then
+// left unfiltered on field, for callers matching the value themselves to scan
+// the full listing.
+func (q *ListQuery) FilterEqualsOrScan(field, value string) *ListQuery {
+	if !filterableValue(value) {
+		return q
+	}
+	return q.Filter(field, FilterEquals, value)
+}
+
+// filterableValue reports whether value can be put in a filter expression
+// without being mistaken for the separators between filters or their parts
+func filterableValue(value string) bool {
+	return !strings.ContainsAny(value, ",:")
+}
+
+func (q *ListQuery) FilterIn(field string, values ...string) *ListQuery {
+	q.filters = append(q.filters, fmt.Sprintf("%s:%s:[%s]", field, FilterIn, strings.Join(values, ",")))
+	return q
+}
+
+func (q *ListQuery) Sort(field string, direction SortDirection) *ListQuery {
+	q.sorts = append(q.sorts, fmt.Sprintf("%s:%s", field, direction))
+	return q
+}
+
+func (q *ListQuery) Fields(fields ...string) *ListQuery {
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// apply adds the query parameters to values, leaving any that were not set untouched
+func (q *ListQuery) apply(values url.Values) {
+	if q == nil {
+		return
+	}
+	if len(q.filters) > 0 {
+		values.Set("filter", strings.Join(q.filters, ","))
+	}
+	if len(q.sorts) > 0 {
+		values.Set("sort", strings.Join(q.sorts, ","))
+	}
+	if len(q.fields) > 0 {
+		values.Set("fields", strings.Join(q.fields, ","))
+	}
+}
+
+func (q *ListQuery) Encode() string {
+	values := url.Values{}
+	q.apply(values)
+	return values.Encode()
+}
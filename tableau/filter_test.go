@@ -0,0 +1,86 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestListQueryEncode(t *testing.T) {
+	query := NewListQuery().
+		Filter("name", FilterEquals, "Finance Reports").
+		FilterIn("ownerName", "alice", "bob").
+		Sort("createdAt", SortDescending).
+		Fields("_default_", "owner.name")
+
+	values, err := url.ParseQuery(query.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]string{
+		"filter": "name:eq:Finance Reports,ownerName:in:[alice,bob]",
+		"sort":   "createdAt:desc",
+		"fields": "_default_,owner.name",
+	}
+	for key, value := range expected {
+		if values.Get(key) != value {
+			t.Errorf("expected %s=%q, got %q", key, value, values.Get(key))
+		}
+	}
+}
+
+func TestListQueryNilLeavesValuesUntouched(t *testing.T) {
+	var query *ListQuery
+	values := url.Values{"pageSize": []string{"100"}}
+	query.apply(values)
+	if len(values) != 1 {
+		t.Errorf("expected values to be untouched, got %v", values)
+	}
+}
+
+func TestListQueryFilterEqualsOrScan(t *testing.T) {
+	cases := map[string]string{
+		"Finance Reports": "name:eq:Finance Reports",
+		"Sales, EMEA":     "",
+		"Sales: EMEA":     "",
+	}
+	for name, expected := range cases {
+		values, err := url.ParseQuery(NewListQuery().FilterEqualsOrScan("name", name).Encode())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if values.Get("filter") != expected {
+			t.Errorf("%q: expected filter=%q, got %q", name, expected, values.Get("filter"))
+		}
+	}
+}
+
+func TestGetGroupNameWithComma(t *testing.T) {
+	filters := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		filters = append(filters, filter)
+		if filter != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"2"},`+
+			`"groups":{"group":[{"id":"g1","name":"Sales"},{"id":"g2","name":"Sales, EMEA"}]}}`)
+	}))
+	t.Cleanup(server.Close)
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client()}
+
+	group, err := c.GetGroup(context.Background(), "", "Sales, EMEA")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if group.ID != "g2" {
+		t.Errorf("expected group g2, got %s", group.ID)
+	}
+	if len(filters) != 1 || filters[0] != "" {
+		t.Errorf("expected a single unfiltered listing, got filters %q", filters)
+	}
+}
@@ -0,0 +1,108 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Flow struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	WebPageURL  string `json:"webpageUrl,omitempty"`
+	FileType    string `json:"fileType,omitempty"`
+	Project     struct {
+		ID string `json:"id,omitempty"`
+	} `json:"project,omitempty"`
+	Owner struct {
+		ID string `json:"id,omitempty"`
+	} `json:"owner,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// FlowUpdate holds the flow settings that can be changed, a flow only
+// allowing its project and owner to be updated
+type FlowUpdate struct {
+	Project *ContentReference `json:"project,omitempty"`
+	Owner   *ContentReference `json:"owner,omitempty"`
+}
+
+type FlowUpdateRequest struct {
+	Flow FlowUpdate `json:"flow"`
+}
+
+type FlowResponse struct {
+	Flow Flow `json:"flow"`
+}
+
+type FlowsResponse struct {
+	Flows []Flow `json:"flow"`
+}
+
+type FlowListResponse struct {
+	FlowsResponse FlowsResponse     `json:"flows"`
+	Pagination    PaginationDetails `json:"pagination"`
+}
+
+func (r FlowListResponse) pageItems() []Flow {
+	return r.FlowsResponse.Flows
+}
+
+func (r FlowListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetFlows(ctx context.Context) ([]Flow, error) {
+	return listAll[Flow, FlowListResponse](ctx, c, fmt.Sprintf("%s/flows", c.ApiUrl), nil)
+}
+
+func (c *Client) GetFlow(ctx context.Context, flowID string) (*Flow, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/flows/%s", c.ApiUrl, flowID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flowResponse := FlowResponse{}
+	err = json.Unmarshal(body, &flowResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &flowResponse.Flow, nil
+}
+
+func (c *Client) UpdateFlow(ctx context.Context, flowID string, flow FlowUpdate) (*Flow, error) {
+	flowRequest := FlowUpdateRequest{
+		Flow: flow,
+	}
+
+	updateFlowJson, err := json.Marshal(flowRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/flows/%s", c.ApiUrl, flowID), strings.NewReader(string(updateFlowJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flowResponse := FlowResponse{}
+	err = json.Unmarshal(body, &flowResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &flowResponse.Flow, nil
+}
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -37,108 +38,49 @@ type GroupListResponse struct {
 	Pagination     PaginationDetails `json:"pagination"`
 }
 
-func (c *Client) GetGroups() ([]Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
-	}
+func (r GroupListResponse) pageItems() []Group {
+	return r.GroupsResponse.Groups
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (r GroupListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
 
-	groupListResponse := GroupListResponse{}
-	err = json.Unmarshal(body, &groupListResponse)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) GetGroups(ctx context.Context) ([]Group, error) {
+	return listAll[Group, GroupListResponse](ctx, c, fmt.Sprintf("%s/groups", c.ApiUrl), nil)
+}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(groupListResponse.Pagination)
-	if err != nil {
-		return nil, err
+// GetGroup looks a group up by ID, filtering the listing by name first when
+// it is known as there is no single group endpoint in the REST API
+func (c *Client) GetGroup(ctx context.Context, groupID, name string) (*Group, error) {
+	matchGroup := func(group Group) bool {
+		return group.ID == groupID || (groupID == "" && group.Name == name)
 	}
-
-	allGroups := make([]Group, 0, totalAvailable)
-	allGroups = append(allGroups, groupListResponse.GroupsResponse.Groups...)
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/groups?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
+	if name != "" {
+		query := NewListQuery().FilterEqualsOrScan("name", name)
+		group, err := findFirst[Group, GroupListResponse](ctx, c, fmt.Sprintf("%s/groups", c.ApiUrl), query, matchGroup)
 		if err != nil {
 			return nil, err
 		}
-		groupListResponse = GroupListResponse{}
-		err = json.Unmarshal(body, &groupListResponse)
-		if err != nil {
-			return nil, err
+		if group != nil {
+			return group, nil
 		}
-		allGroups = append(allGroups, groupListResponse.GroupsResponse.Groups...)
-	}
-
-	return allGroups, nil
-}
-
-func (c *Client) GetGroup(groupID string) (*Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
 	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	if groupID == "" {
+		return nil, fmt.Errorf("Did not find group named %s: %w", name, ErrNotFound)
 	}
 
-	groupListResponse := GroupListResponse{}
-	err = json.Unmarshal(body, &groupListResponse)
+	group, err := findFirst[Group, GroupListResponse](ctx, c, fmt.Sprintf("%s/groups", c.ApiUrl), nil, matchGroup)
 	if err != nil {
 		return nil, err
 	}
-
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, _, err := GetPaginationNumbers(groupListResponse.Pagination)
-	if err != nil {
-		return nil, err
+	if group == nil {
+		return nil, fmt.Errorf("Did not find group ID %s: %w", groupID, ErrNotFound)
 	}
-	for i, group := range groupListResponse.GroupsResponse.Groups {
-		if group.ID == groupID {
-			return &groupListResponse.GroupsResponse.Groups[i], nil
-		}
-	}
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/groups?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		groupListResponse = GroupListResponse{}
-		err = json.Unmarshal(body, &groupListResponse)
-		if err != nil {
-			return nil, err
-		}
-		// check if we found the group in this page
-		for i, group := range groupListResponse.GroupsResponse.Groups {
-			if group.ID == groupID {
-				return &groupListResponse.GroupsResponse.Groups[i], nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("Did not find group ID %s", groupID)
+	return group, nil
 }
 
-func (c *Client) CreateGroup(name, minimumSiteRole string) (*Group, error) {
+func (c *Client) CreateGroup(ctx context.Context, name, minimumSiteRole string) (*Group, error) {
 
 	newGroup := Group{
 		Name:            name,
@@ -153,7 +95,7 @@ func (c *Client) CreateGroup(name, minimumSiteRole string) (*Group, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/groups", c.ApiUrl), strings.NewReader(string(newGroupJson)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/groups", c.ApiUrl), strings.NewReader(string(newGroupJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +114,7 @@ func (c *Client) CreateGroup(name, minimumSiteRole string) (*Group, error) {
 	return &groupResponse.Group, nil
 }
 
-func (c *Client) UpdateGroup(groupID, name, minimumSiteRole string) (*Group, error) {
+func (c *Client) UpdateGroup(ctx context.Context, groupID, name, minimumSiteRole string) (*Group, error) {
 
 	group := Group{
 		Name:            name,
@@ -187,7 +129,7 @@ func (c *Client) UpdateGroup(groupID, name, minimumSiteRole string) (*Group, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), strings.NewReader(string(updateGroupJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), strings.NewReader(string(updateGroupJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -206,9 +148,9 @@ func (c *Client) UpdateGroup(groupID, name, minimumSiteRole string) (*Group, err
 	return &groupResponse.Group, nil
 }
 
-func (c *Client) DeleteGroup(groupID string) error {
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), nil)
 	if err != nil {
 		return err
 	}
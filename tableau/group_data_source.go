@@ -25,6 +25,7 @@ type groupDataSourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	MinimumSiteRole types.String `tfsdk:"minimum_site_role"`
+	Site            types.String `tfsdk:"site"`
 }
 
 func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,6 +40,7 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Required:    true,
 				Description: "ID of the group",
 			},
+			"site": dataSourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Computed:    true,
 				Description: "Name for the group",
@@ -56,7 +58,13 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	group, err := d.client.GetGroup(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := client.GetGroup(ctx, state.ID.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Group",
@@ -69,7 +77,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	state.Name = types.StringValue(group.Name)
 	state.MinimumSiteRole = types.StringValue(*group.Import.MinimumSiteRole)
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -33,6 +32,7 @@ type groupResourceModel struct {
 	Name            types.String `tfsdk:"name"`
 	MinimumSiteRole types.String `tfsdk:"minimum_site_role"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
+	Site            types.String `tfsdk:"site"`
 }
 
 func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +48,7 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Display name for group",
@@ -85,6 +86,12 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	group := Group{
 		Name: plan.Name.ValueString(),
 	}
@@ -92,7 +99,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		group.MinimumSiteRole = plan.MinimumSiteRole.ValueString()
 	}
 
-	createdGroup, err := r.client.CreateGroup(group.Name, group.MinimumSiteRole)
+	createdGroup, err := client.CreateGroup(ctx, group.Name, group.MinimumSiteRole)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
@@ -119,9 +126,22 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	group, err := r.client.GetGroup(state.ID.ValueString())
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := client.GetGroup(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Group",
+			"Could not read Tableau group ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -149,12 +169,18 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	group := Group{
 		Name:            plan.Name.ValueString(),
 		MinimumSiteRole: plan.MinimumSiteRole.ValueString(),
 	}
 
-	_, err := r.client.UpdateGroup(plan.ID.ValueString(), group.Name, group.MinimumSiteRole)
+	_, err := client.UpdateGroup(ctx, plan.ID.ValueString(), group.Name, group.MinimumSiteRole)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Group",
@@ -163,7 +189,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updatedGroup, err := r.client.GetGroup(plan.ID.ValueString())
+	updatedGroup, err := client.GetGroup(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Group",
@@ -191,7 +217,13 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteGroup(state.ID.ValueString())
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteGroup(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Group",
@@ -210,5 +242,5 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,61 +21,33 @@ type GroupUsersListResponse struct {
 	Pagination         PaginationDetails  `json:"pagination"`
 }
 
-func (c *Client) GetGroupUser(groupID, userID string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (r GroupUsersListResponse) pageItems() []User {
+	return r.GroupUsersResponse.Users
+}
 
-	groupUsersListResponse := GroupUsersListResponse{}
-	err = json.Unmarshal(body, &groupUsersListResponse)
-	if err != nil {
-		return nil, err
-	}
+func (r GroupUsersListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, _, err := GetPaginationNumbers(groupUsersListResponse.Pagination)
+func (c *Client) GetGroupUser(ctx context.Context, groupID, userID string) (*User, error) {
+	user, err := findFirst[User, GroupUsersListResponse](ctx, c, fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), nil, func(user User) bool {
+		return user.ID == userID
+	})
 	if err != nil {
 		return nil, err
 	}
-	for i, user := range groupUsersListResponse.GroupUsersResponse.Users {
-		if user.ID == userID {
-			return &groupUsersListResponse.GroupUsersResponse.Users[i], nil
-		}
-	}
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/groups/%s/users?pageNumber=%d", c.ApiUrl, groupID, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		groupUsersListResponse = GroupUsersListResponse{}
-		err = json.Unmarshal(body, &groupUsersListResponse)
-		if err != nil {
-			return nil, err
-		}
-		// Check this page of data for the GroupUser match
-		for i, user := range groupUsersListResponse.GroupUsersResponse.Users {
-			if user.ID == userID {
-				return &groupUsersListResponse.GroupUsersResponse.Users[i], nil
-			}
-		}
+	if user == nil {
+		return nil, fmt.Errorf("Did not find user ID %s in group ID %s: %w", userID, groupID, ErrNotFound)
 	}
+	return user, nil
+}
 
-	return nil, fmt.Errorf("Did not find user ID %s in group ID %s", userID, groupID)
+// GetUserGroups returns the groups a user belongs to
+func (c *Client) GetUserGroups(ctx context.Context, userID string) ([]Group, error) {
+	return listAll[Group, GroupListResponse](ctx, c, fmt.Sprintf("%s/users/%s/groups", c.ApiUrl, userID), nil)
 }
 
-func (c *Client) CreateGroupUser(groupID, userID string) (*User, error) {
+func (c *Client) CreateGroupUser(ctx context.Context, groupID, userID string) (*User, error) {
 
 	newGroupUser := User{
 		ID: userID,
@@ -88,7 +61,7 @@ func (c *Client) CreateGroupUser(groupID, userID string) (*User, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), strings.NewReader(string(newGroupUserJson)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), strings.NewReader(string(newGroupUserJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -107,9 +80,9 @@ func (c *Client) CreateGroupUser(groupID, userID string) (*User, error) {
 	return &groupUserResponse.User, nil
 }
 
-func (c *Client) DeleteGroupUser(groupID, userID string) error {
+func (c *Client) DeleteGroupUser(ctx context.Context, groupID, userID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s/users/%s", c.ApiUrl, groupID, userID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/groups/%s/users/%s", c.ApiUrl, groupID, userID), nil)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,6 +30,7 @@ type groupUserResourceModel struct {
 	GroupID     types.String `tfsdk:"group_id"`
 	UserID      types.String `tfsdk:"user_id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (r *groupUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,6 +46,7 @@ func (r *groupUserResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"group_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Group identifier",
@@ -69,11 +70,17 @@ func (r *groupUserResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	groupUser := User{
 		ID: plan.UserID.ValueString(),
 	}
 
-	_, err := r.client.CreateGroupUser(plan.GroupID.ValueString(), groupUser.ID)
+	_, err := client.CreateGroupUser(ctx, plan.GroupID.ValueString(), groupUser.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating groupUser",
@@ -101,15 +108,28 @@ func (r *groupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	groupID := state.GroupID.ValueString()
 	userID := state.UserID.ValueString()
 	if (groupID == "") || (userID == "") {
 		groupID, userID = GetIDsFromCombinedID(state.ID.ValueString())
 	}
 
-	groupUser, err := r.client.GetGroupUser(groupID, userID)
+	groupUser, err := client.GetGroupUser(ctx, groupID, userID)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Group User",
+			"Could not read Tableau group user ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -137,7 +157,13 @@ func (r *groupUserResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteGroupUser(state.GroupID.ValueString(), state.UserID.ValueString())
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteGroupUser(ctx, state.GroupID.ValueString(), state.UserID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Group User",
@@ -156,5 +182,5 @@ func (r *groupUserResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func (r *groupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
@@ -30,6 +30,7 @@ type groupsNestedDataModel struct {
 type groupsDataSourceModel struct {
 	ID     types.String            `tfsdk:"id"`
 	Groups []groupsNestedDataModel `tfsdk:"groups"`
+	Site   types.String            `tfsdk:"site"`
 }
 
 func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +45,7 @@ func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "ID of the list of groups",
 			},
+			"site": dataSourceSiteAttribute(),
 			"groups": schema.ListNestedAttribute{
 				Description: "List of groups and their attributes",
 				Computed:    true,
@@ -73,7 +75,13 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	groups, err := d.client.GetGroups()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := client.GetGroups(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Groups",
@@ -93,7 +101,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	state.ID = types.StringValue("allGroups")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
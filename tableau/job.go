@@ -0,0 +1,216 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// finish codes of a completed job
+const (
+	JobFinishSucceeded = "0"
+	JobFinishFailed    = "1"
+	JobFinishCancelled = "2"
+)
+
+type JobStatusNote struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+	Text  string `json:"text,omitempty"`
+}
+
+type Job struct {
+	ID          string      `json:"id,omitempty"`
+	Mode        string      `json:"mode,omitempty"`
+	Type        string      `json:"type,omitempty"`
+	Progress    json.Number `json:"progress,omitempty"`
+	CreatedAt   string      `json:"createdAt,omitempty"`
+	StartedAt   string      `json:"startedAt,omitempty"`
+	CompletedAt string      `json:"completedAt,omitempty"`
+	FinishCode  json.Number `json:"finishCode,omitempty"`
+	Notes       string      `json:"notes,omitempty"`
+	StatusNotes struct {
+		StatusNotes []JobStatusNote `json:"statusNote"`
+	} `json:"statusNotes"`
+	ExtractRefreshJob *struct {
+		Notes      string            `json:"notes,omitempty"`
+		Workbook   *ContentReference `json:"workbook,omitempty"`
+		Datasource *ContentReference `json:"datasource,omitempty"`
+	} `json:"extractRefreshJob,omitempty"`
+}
+
+type JobResponse struct {
+	Job Job `json:"job"`
+}
+
+// Finished reports whether the job has stopped running, successfully or not
+func (j Job) Finished() bool {
+	return j.CompletedAt != ""
+}
+
+// Succeeded reports whether the job finished without error
+func (j Job) Succeeded() bool {
+	return j.Finished() && j.FinishCode.String() == JobFinishSucceeded
+}
+
+// FailureNotes returns what the job reported about why it failed
+func (j Job) FailureNotes() []string {
+	notes := []string{}
+	for _, note := range []string{j.Notes, extractRefreshJobNotes(j)} {
+		if note != "" && !slices.Contains(notes, note) {
+			notes = append(notes, note)
+		}
+	}
+	for _, statusNote := range j.StatusNotes.StatusNotes {
+		note := statusNote.Text
+		if note == "" {
+			note = statusNote.Value
+		}
+		if note != "" && !slices.Contains(notes, note) {
+			notes = append(notes, note)
+		}
+	}
+	return notes
+}
+
+func extractRefreshJobNotes(job Job) string {
+	if job.ExtractRefreshJob == nil {
+		return ""
+	}
+	return job.ExtractRefreshJob.Notes
+}
+
+func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/jobs/%s", c.ApiUrl, jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	jobResponse := JobResponse{}
+	err = json.Unmarshal(body, &jobResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &jobResponse.Job, nil
+}
+
+// WaitForJob polls a job until it finishes or ctx is done, returning the
+// finished job whether or not it succeeded. The job is left running when
+// ctx is done first, with the last state seen returned alongside the error.
+func (c *Client) WaitForJob(ctx context.Context, jobID string) (*Job, error) {
+	started := time.Now()
+	var last *Job
+	job, err := pollUntil(ctx, c.jobPollPolicy(), func(ctx context.Context, poll int) (*Job, bool, error) {
+		job, err := c.GetJob(ctx, jobID)
+		if err != nil {
+			return nil, false, err
+		}
+		last = job
+		if !job.Finished() {
+			tflog.Debug(ctx, "Waiting for Tableau job", map[string]any{
+				"job_id":   jobID,
+				"job_type": job.Type,
+				"progress": job.Progress.String(),
+				"poll":     poll,
+				"elapsed":  time.Since(started).Round(time.Second).String(),
+			})
+		}
+		return job, job.Finished(), nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return last, fmt.Errorf("job ID %s had not finished: %w", jobID, ctx.Err())
+		}
+		return nil, err
+	}
+
+	tflog.Info(ctx, "Tableau job finished", map[string]any{
+		"job_id":      jobID,
+		"job_type":    job.Type,
+		"finish_code": job.FinishCode.String(),
+		"elapsed":     time.Since(started).Round(time.Second).String(),
+	})
+	return job, nil
+}
+
+// RefreshWorkbook starts a refresh of a workbook's extracts, returning the
+// job running it
+func (c *Client) RefreshWorkbook(ctx context.Context, workbookID string) (*Job, error) {
+	return c.runRefresh(ctx, fmt.Sprintf("%s/workbooks/%s/refresh", c.ApiUrl, workbookID))
+}
+
+// RefreshDatasource starts a refresh of a data source's extract, returning
+// the job running it
+func (c *Client) RefreshDatasource(ctx context.Context, datasourceID string) (*Job, error) {
+	return c.runRefresh(ctx, fmt.Sprintf("%s/datasources/%s/refresh", c.ApiUrl, datasourceID))
+}
+
+func (c *Client) runRefresh(ctx context.Context, endpoint string) (*Job, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader("{}"))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	jobResponse := JobResponse{}
+	err = json.Unmarshal(body, &jobResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &jobResponse.Job, nil
+}
+
+// background job statuses, as filtered on when listing jobs
+var backgroundJobStatuses = []string{"Pending", "InProgress", "Success", "Failed", "Cancelled"}
+
+// BackgroundJob is a job as listed for a site, carrying its status rather
+// than the finish code of a single job
+type BackgroundJob struct {
+	ID        string      `json:"id,omitempty"`
+	Status    string      `json:"status,omitempty"`
+	JobType   string      `json:"jobType,omitempty"`
+	Priority  json.Number `json:"priority,omitempty"`
+	Title     string      `json:"title,omitempty"`
+	Subtitle  string      `json:"subtitle,omitempty"`
+	CreatedAt string      `json:"createdAt,omitempty"`
+	StartedAt string      `json:"startedAt,omitempty"`
+	EndedAt   string      `json:"endedAt,omitempty"`
+}
+
+type BackgroundJobsResponse struct {
+	BackgroundJobs []BackgroundJob `json:"backgroundJob"`
+}
+
+type BackgroundJobListResponse struct {
+	BackgroundJobsResponse BackgroundJobsResponse `json:"backgroundJobs"`
+	Pagination             PaginationDetails      `json:"pagination"`
+}
+
+func (r BackgroundJobListResponse) pageItems() []BackgroundJob {
+	return r.BackgroundJobsResponse.BackgroundJobs
+}
+
+func (r BackgroundJobListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+// GetBackgroundJobs lists the site's jobs, narrowed by the optional
+// listQuery on fields such as jobType, status and createdAt
+func (c *Client) GetBackgroundJobs(ctx context.Context, listQuery *ListQuery) ([]BackgroundJob, error) {
+	return listAll[BackgroundJob, BackgroundJobListResponse](ctx, c, fmt.Sprintf("%s/jobs", c.ApiUrl), listQuery)
+}
@@ -0,0 +1,58 @@
+package tableau
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+const (
+	defaultJobPollMinInterval = 2 * time.Second
+	defaultJobPollMaxInterval = 30 * time.Second
+	jobPollGrowth             = 1.5
+)
+
+// JobPollPolicy controls how often a running job is checked on, the wait
+// growing from MinInterval towards MaxInterval the longer the job runs
+type JobPollPolicy struct {
+	MinInterval time.Duration
+	MaxInterval time.Duration
+}
+
+func DefaultJobPollPolicy() JobPollPolicy {
+	return JobPollPolicy{
+		MinInterval: defaultJobPollMinInterval,
+		MaxInterval: defaultJobPollMaxInterval,
+	}
+}
+
+// interval returns how long to wait after the given poll, counting from 1
+func (p JobPollPolicy) interval(poll int) time.Duration {
+	wait := time.Duration(float64(p.MinInterval) * math.Pow(jobPollGrowth, float64(poll-1)))
+	if p.MaxInterval > 0 && (wait > p.MaxInterval || wait <= 0) {
+		wait = p.MaxInterval
+	}
+	return wait
+}
+
+func (c *Client) jobPollPolicy() JobPollPolicy {
+	if c.JobPollPolicy.MinInterval <= 0 {
+		return DefaultJobPollPolicy()
+	}
+	return c.JobPollPolicy
+}
+
+// pollUntil calls check until it reports being done or fails, waiting
+// between calls as policy says, and gives up with the context's error once
+// ctx is done
+func pollUntil[T any](ctx context.Context, policy JobPollPolicy, check func(ctx context.Context, poll int) (T, bool, error)) (T, error) {
+	for poll := 1; ; poll++ {
+		value, done, err := check(ctx, poll)
+		if err != nil || done {
+			return value, err
+		}
+		if err := waitForRetry(ctx, policy.interval(poll)); err != nil {
+			return value, err
+		}
+	}
+}
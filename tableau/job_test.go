@@ -0,0 +1,125 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newJobServer(t *testing.T, runningPolls int, finished string) (*httptest.Server, *int) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls <= runningPolls {
+			w.Write([]byte(`{"job": {"id": "j1", "progress": "50"}}`))
+			return
+		}
+		w.Write([]byte(finished))
+	}))
+	t.Cleanup(server.Close)
+	return server, &polls
+}
+
+func TestWaitForJob(t *testing.T) {
+	server, polls := newJobServer(t, 2, `{"job": {"id": "j1", "completedAt": "2024-01-01T06:00:00Z", "finishCode": "0"}}`)
+	c := testRetryClient(server)
+	c.JobPollPolicy = JobPollPolicy{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	job, err := c.WaitForJob(context.Background(), "j1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !job.Succeeded() {
+		t.Errorf("expected the job to have succeeded, got %+v", job)
+	}
+	if *polls != 3 {
+		t.Errorf("expected 3 polls, got %d", *polls)
+	}
+}
+
+func TestWaitForJobTimesOut(t *testing.T) {
+	server, _ := newJobServer(t, 1000, "")
+	c := testRetryClient(server)
+	c.JobPollPolicy = JobPollPolicy{MinInterval: 10 * time.Millisecond, MaxInterval: 10 * time.Millisecond}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+	job, err := c.WaitForJob(ctx, "j1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got %v", err)
+	}
+	if job == nil || job.Finished() {
+		t.Errorf("expected the last running state of the job, got %+v", job)
+	}
+}
+
+func TestJobPollPolicyInterval(t *testing.T) {
+	policy := JobPollPolicy{MinInterval: 2 * time.Second, MaxInterval: 5 * time.Second}
+	expected := []time.Duration{2 * time.Second, 3 * time.Second, 4500 * time.Millisecond, 5 * time.Second, 5 * time.Second}
+	for i, wait := range expected {
+		if interval := policy.interval(i + 1); interval != wait {
+			t.Errorf("poll %d: expected %s, got %s", i+1, wait, interval)
+		}
+	}
+}
+
+func TestPollUntilStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := JobPollPolicy{MinInterval: time.Hour}
+	calls := 0
+	_, err := pollUntil(ctx, policy, func(ctx context.Context, poll int) (int, bool, error) {
+		calls++
+		cancel()
+		return poll, false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a cancelled error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestBackgroundJobListResponse(t *testing.T) {
+	body := `{"pagination": {"pageNumber": "1", "pageSize": "100", "totalAvailable": "1"},
+		"backgroundJobs": {"backgroundJob": [{"id": "j1", "status": "Failed", "jobType": "refresh_extracts",
+			"priority": "50", "createdAt": "2024-01-01T06:00:00Z", "title": "Orders", "subtitle": "Data Source"}]}}`
+	listResponse := BackgroundJobListResponse{}
+	if err := json.Unmarshal([]byte(body), &listResponse); err != nil {
+		t.Fatalf("unexpected error decoding jobs: %s", err)
+	}
+	jobs := listResponse.pageItems()
+	if len(jobs) != 1 || jobs[0].Status != "Failed" || jobs[0].JobType != "refresh_extracts" || jobs[0].EndedAt != "" {
+		t.Errorf("unexpected jobs %+v", jobs)
+	}
+}
+
+func TestJobFailureNotes(t *testing.T) {
+	body := `{"job": {"id": "j1", "completedAt": "2024-01-01T06:00:00Z", "finishCode": 1,
+		"notes": "Connection failed",
+		"extractRefreshJob": {"notes": "Connection failed", "datasource": {"id": "d1"}},
+		"statusNotes": {"statusNote": [{"type": "ErrorCode", "value": "Bad credentials"}, {"text": "Refresh aborted"}]}}}`
+	jobResponse := JobResponse{}
+	if err := json.Unmarshal([]byte(body), &jobResponse); err != nil {
+		t.Fatalf("unexpected error decoding job: %s", err)
+	}
+	job := jobResponse.Job
+	if !job.Finished() || job.Succeeded() {
+		t.Errorf("expected a finished, failed job, got %+v", job)
+	}
+
+	expected := []string{"Connection failed", "Bad credentials", "Refresh aborted"}
+	notes := job.FailureNotes()
+	if len(notes) != len(expected) {
+		t.Fatalf("expected notes %v, got %v", expected, notes)
+	}
+	for i := range expected {
+		if notes[i] != expected[i] {
+			t.Errorf("expected notes %v, got %v", expected, notes)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jobsDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobsDataSource{}
+)
+
+func JobsDataSource() datasource.DataSource {
+	return &jobsDataSource{}
+}
+
+type jobsDataSource struct {
+	client *Client
+}
+
+type jobsNestedDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	JobType   types.String `tfsdk:"job_type"`
+	Status    types.String `tfsdk:"status"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Title     types.String `tfsdk:"title"`
+	Subtitle  types.String `tfsdk:"subtitle"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	StartedAt types.String `tfsdk:"started_at"`
+	EndedAt   types.String `tfsdk:"ended_at"`
+}
+
+type jobsDataSourceModel struct {
+	ID            types.String          `tfsdk:"id"`
+	JobType       types.String          `tfsdk:"job_type"`
+	Status        types.String          `tfsdk:"status"`
+	CreatedAfter  types.String          `tfsdk:"created_after"`
+	CreatedBefore types.String          `tfsdk:"created_before"`
+	Jobs          []jobsNestedDataModel `tfsdk:"jobs"`
+	Site          types.String          `tfsdk:"site"`
+}
+
+func (d *jobsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jobs"
+}
+
+func (d *jobsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the background jobs of a site, such as extract refreshes, flow runs and subscriptions",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the list of jobs",
+			},
+			"site": dataSourceSiteAttribute(),
+			"job_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list jobs of this type, e.g. refresh_extracts, increment_extracts or run_flow",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list jobs with this status, one of " + strings.Join(backgroundJobStatuses, "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(backgroundJobStatuses...),
+				},
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list jobs created at or after this RFC 3339 timestamp, e.g. 2024-01-01T00:00:00Z",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list jobs created before this RFC 3339 timestamp",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Description: "List of jobs and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Job ID",
+							Computed:    true,
+						},
+						"job_type": schema.StringAttribute{
+							Description: "Type of the job",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the job",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the job",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of the job, usually naming the content it runs on",
+							Computed:    true,
+						},
+						"subtitle": schema.StringAttribute{
+							Description: "Subtitle of the job, usually naming the kind of content it runs on",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the job was created",
+							Computed:    true,
+						},
+						"started_at": schema.StringAttribute{
+							Description: "When the job started running, null while it is pending",
+							Computed:    true,
+						},
+						"ended_at": schema.StringAttribute{
+							Description: "When the job stopped running, null while it is pending or in progress",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jobsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := NewListQuery()
+	if !state.JobType.IsNull() {
+		query.Filter("jobType", FilterEquals, state.JobType.ValueString())
+	}
+	if !state.Status.IsNull() {
+		query.Filter("status", FilterEquals, state.Status.ValueString())
+	}
+	for _, createdFilter := range []struct {
+		attribute string
+		value     types.String
+		operator  FilterOperator
+	}{
+		{"created_after", state.CreatedAfter, FilterGreaterThanOrEquals},
+		{"created_before", state.CreatedBefore, FilterLessThan},
+	} {
+		if createdFilter.value.IsNull() {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, createdFilter.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(createdFilter.attribute),
+				"Invalid Timestamp",
+				"Expected an RFC 3339 timestamp such as 2024-01-01T00:00:00Z: "+err.Error(),
+			)
+			continue
+		}
+		query.Filter("createdAt", createdFilter.operator, createdAt.UTC().Format(time.RFC3339))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs, err := client.GetBackgroundJobs(ctx, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Jobs",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Jobs = []jobsNestedDataModel{}
+	for _, job := range jobs {
+		jobModel := jobsNestedDataModel{
+			ID:        types.StringValue(job.ID),
+			JobType:   types.StringValue(job.JobType),
+			Status:    types.StringValue(job.Status),
+			Priority:  jsonNumberInt64(job.Priority),
+			Title:     types.StringValue(job.Title),
+			Subtitle:  types.StringValue(job.Subtitle),
+			CreatedAt: types.StringValue(job.CreatedAt),
+			StartedAt: types.StringNull(),
+			EndedAt:   types.StringNull(),
+		}
+		if job.StartedAt != "" {
+			jobModel.StartedAt = types.StringValue(job.StartedAt)
+		}
+		if job.EndedAt != "" {
+			jobModel.EndedAt = types.StringValue(job.EndedAt)
+		}
+		state.Jobs = append(state.Jobs, jobModel)
+	}
+
+	state.ID = types.StringValue("allJobs")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *jobsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
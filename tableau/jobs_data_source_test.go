@@ -0,0 +1,27 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJobsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tableau_jobs" "test" {
+  status = "Failed"
+  created_after = "2024-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_jobs.test", "id"),
+					resource.TestCheckResourceAttrSet("data.tableau_jobs.test", "jobs.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// handling for string attributes holding JSON documents, which are compared
+// by value so formatting and key order are not mistaken for drift
+
+// normalizeJSON re-encodes a JSON document with sorted keys and no
+// insignificant whitespace
+func normalizeJSON(value string) (string, error) {
+	// numbers are kept as written so large identifiers are not rounded
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return "", err
+	}
+	if decoder.More() {
+		return "", fmt.Errorf("unexpected content after the JSON document")
+	}
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+func jsonSemanticallyEqual(a, b string) bool {
+	normalizedA, err := normalizeJSON(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := normalizeJSON(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := normalizeJSON(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"Could not parse "+req.Path.String()+" as JSON: "+err.Error(),
+		)
+	}
+}
+
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonStringValue{}
+)
+
+// jsonStringType is a string attribute holding a JSON document, whose values
+// the framework keeps as they were when a new value only differs in
+// formatting or key order
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringValue{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return jsonStringValue{StringValue: stringValue}, nil
+}
+
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonStringValue{}
+}
+
+type jsonStringValue struct {
+	basetypes.StringValue
+}
+
+func jsonStringValueOf(value string) jsonStringValue {
+	return jsonStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonStringValue) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+func (v jsonStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(jsonStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected a JSON string value, got %T", newValuable),
+		)
+		return false, diags
+	}
+	return jsonSemanticallyEqual(v.ValueString(), newValue.ValueString()), diags
+}
@@ -0,0 +1,38 @@
+package tableau
+
+import (
+	"context"
+	"testing"
+)
+
+func TestJSONSemanticallyEqual(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`{"a":1,"b":[1,2]}`, "{\n  \"b\": [1, 2],\n  \"a\": 1\n}", true},
+		{`{"id":12345678901234567890}`, `{"id":12345678901234567891}`, false},
+		{`{"b":[1,2]}`, `{"b":[2,1]}`, false},
+		{`{"a":1}`, `{"a":1} {"a":1}`, false},
+		{`{"a":1}`, `not json`, false},
+	}
+	for _, c := range cases {
+		if equal := jsonSemanticallyEqual(c.a, c.b); equal != c.equal {
+			t.Errorf("jsonSemanticallyEqual(%q, %q) = %t, expected %t", c.a, c.b, equal, c.equal)
+		}
+	}
+}
+
+func TestJSONStringValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	configured := jsonStringValueOf("{\n  \"name\": \"Sales\",\n  \"tables\": []\n}")
+
+	equal, diags := configured.StringSemanticEquals(ctx, jsonStringValueOf(`{"tables":[],"name":"Sales"}`))
+	if diags.HasError() || !equal {
+		t.Errorf("expected reformatted JSON to be semantically equal, got %t %v", equal, diags)
+	}
+	equal, diags = configured.StringSemanticEquals(ctx, jsonStringValueOf(`{"tables":[],"name":"Marketing"}`))
+	if diags.HasError() || equal {
+		t.Errorf("expected changed JSON not to be semantically equal, got %t %v", equal, diags)
+	}
+}
@@ -0,0 +1,145 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listResponse is implemented by every paginated list response, exposing the
+// items held on the page alongside the pagination block
+type listResponse[T any] interface {
+	pageItems() []T
+	pagination() PaginationDetails
+}
+
+// PaginationError reports which page of a list call failed and how far the
+// listing had progressed before it did
+type PaginationError struct {
+	Endpoint   string
+	Page       int
+	TotalPages int
+	Retrieved  int
+	Err        error
+}
+
+func (e *PaginationError) Error() string {
+	if e.TotalPages > 0 {
+		return fmt.Sprintf("failed fetching page %d of %d from %s after retrieving %d items: %s", e.Page, e.TotalPages, e.Endpoint, e.Retrieved, e.Err)
+	}
+	return fmt.Sprintf("failed fetching page %d from %s after retrieving %d items: %s", e.Page, e.Endpoint, e.Retrieved, e.Err)
+}
+
+func (e *PaginationError) Unwrap() error {
+	return e.Err
+}
+
+func (c *Client) listPageSize() int {
+	if c.PageSize <= 0 {
+		return defaultPageSize
+	}
+	if c.PageSize > maxPageSize {
+		return maxPageSize
+	}
+	return c.PageSize
+}
+
+// forEachPage requests the pages of endpoint in order, narrowed by the
+// optional listQuery, handing each page of items to fn, and stops once fn
+// returns true or the last page is reached
+func forEachPage[T any, R listResponse[T]](ctx context.Context, c *Client, endpoint string, listQuery *ListQuery, fn func(items []T) bool) error {
+	pageURL, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	query := pageURL.Query()
+	listQuery.apply(query)
+	query.Set("pageSize", strconv.Itoa(c.listPageSize()))
+
+	retrieved := 0
+	totalPageCount := 0
+	for page := 1; totalPageCount == 0 || page <= totalPageCount; page++ {
+		query.Set("pageNumber", strconv.Itoa(page))
+		pageURL.RawQuery = query.Encode()
+
+		pageErr := func(err error) error {
+			return &PaginationError{
+				Endpoint:   endpoint,
+				Page:       page,
+				TotalPages: totalPageCount,
+				Retrieved:  retrieved,
+				Err:        err,
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL.String(), nil)
+		if err != nil {
+			return pageErr(err)
+		}
+		body, err := c.doRequest(req)
+		if err != nil {
+			return pageErr(err)
+		}
+		var response R
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			return pageErr(err)
+		}
+		_, totalPageCount, _, err = GetPaginationNumbers(response.pagination())
+		if err != nil {
+			return pageErr(err)
+		}
+
+		items := response.pageItems()
+		retrieved += len(items)
+		if fn(items) {
+			return nil
+		}
+		// guard against an empty listing reporting zero pages
+		if totalPageCount == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// listAll returns every item from a paginated list endpoint
+func listAll[T any, R listResponse[T]](ctx context.Context, c *Client, endpoint string, listQuery *ListQuery) ([]T, error) {
+	allItems := []T{}
+	err := forEachPage[T, R](ctx, c, endpoint, listQuery, func(items []T) bool {
+		allItems = append(allItems, items...)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allItems, nil
+}
+
+// findFirst returns the first item from a paginated list endpoint that
+// satisfies match, without requesting any pages beyond the one it is found on
+func findFirst[T any, R listResponse[T]](ctx context.Context, c *Client, endpoint string, listQuery *ListQuery, match func(T) bool) (*T, error) {
+	var found *T
+	err := forEachPage[T, R](ctx, c, endpoint, listQuery, func(items []T) bool {
+		for i := range items {
+			if match(items[i]) {
+				found = &items[i]
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
@@ -0,0 +1,84 @@
+package tableau
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newPagedUsersServer(t *testing.T, totalAvailable int, failPage string) (*httptest.Server, *[]string) {
+	requestedPages := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageNumber := r.URL.Query().Get("pageNumber")
+		pageSize := r.URL.Query().Get("pageSize")
+		requestedPages = append(requestedPages, pageNumber)
+		if pageNumber == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var page, size int
+		fmt.Sscan(pageNumber, &page)
+		fmt.Sscan(pageSize, &size)
+		users := ""
+		for i := (page - 1) * size; i < page*size && i < totalAvailable; i++ {
+			if users != "" {
+				users += ","
+			}
+			users += fmt.Sprintf(`{"id":"user-%d"}`, i)
+		}
+		fmt.Fprintf(w, `{"pagination":{"pageNumber":"%s","pageSize":"%s","totalAvailable":"%d"},"users":{"user":[%s]}}`, pageNumber, pageSize, totalAvailable, users)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requestedPages
+}
+
+func TestListAllPages(t *testing.T) {
+	server, requestedPages := newPagedUsersServer(t, 25, "")
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client(), PageSize: 10}
+
+	users, err := c.GetUsers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(users) != 25 {
+		t.Errorf("expected 25 users, got %d", len(users))
+	}
+	if len(*requestedPages) != 3 {
+		t.Errorf("expected 3 pages to be requested, got %v", *requestedPages)
+	}
+}
+
+func TestFindFirstStopsEarly(t *testing.T) {
+	server, requestedPages := newPagedUsersServer(t, 50, "")
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client(), PageSize: 10}
+
+	user, err := findFirst[User, UserListResponse](context.Background(), c, server.URL+"/users", nil, func(user User) bool {
+		return user.ID == "user-12"
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if user == nil || user.ID != "user-12" {
+		t.Fatalf("expected to find user-12, got %v", user)
+	}
+	if len(*requestedPages) != 2 {
+		t.Errorf("expected paging to stop after 2 pages, got %v", *requestedPages)
+	}
+}
+
+func TestListAllReportsFailedPage(t *testing.T) {
+	server, _ := newPagedUsersServer(t, 25, "2")
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client(), PageSize: 10}
+
+	_, err := c.GetUsers(context.Background())
+	var paginationErr *PaginationError
+	if !errors.As(err, &paginationErr) {
+		t.Fatalf("expected a PaginationError, got %v", err)
+	}
+	if paginationErr.Page != 2 || paginationErr.TotalPages != 3 || paginationErr.Retrieved != 10 {
+		t.Errorf("unexpected pagination progress: %+v", paginationErr)
+	}
+}
@@ -0,0 +1,135 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &permissionTemplateDataSource{}
+)
+
+func PermissionTemplateDataSource() datasource.DataSource {
+	return &permissionTemplateDataSource{}
+}
+
+// permissionTemplateDataSource expands templates locally, without calling
+// the server
+type permissionTemplateDataSource struct{}
+
+type permissionTemplateDataSourceModel struct {
+	ID              types.String      `tfsdk:"id"`
+	ContentType     types.String      `tfsdk:"content_type"`
+	Template        types.String      `tfsdk:"template"`
+	Mode            types.String      `tfsdk:"mode"`
+	CapabilityNames []types.String    `tfsdk:"capability_names"`
+	Capabilities    []CapabilityModel `tfsdk:"capabilities"`
+}
+
+func (d *permissionTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_template"
+}
+
+func (d *permissionTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Expand a permission template such as Explore into the capabilities it grants on a type of content, " +
+			"for use in the grantee_capabilities of the permissions resources",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the template, in the form content_type/template/mode",
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of content, one of " + strings.Join(permissionTemplateContentTypes(), "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionTemplateContentTypes()...),
+				},
+			},
+			"template": schema.StringAttribute{
+				Required:    true,
+				Description: "Permission template, one of " + strings.Join(permissionTemplateNames, "/") + ", each including every capability of the ones before it",
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionTemplateNames...),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Capability mode given to every capability, Allow or Deny (case sensitive), defaults to Allow",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Allow",
+						"Deny",
+					}...),
+				},
+			},
+			"capability_names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the capabilities the template grants",
+			},
+			"capabilities": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Capabilities the template grants, with the mode",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the capability",
+						},
+						"mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "Mode of the capability (Allow/Deny)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *permissionTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state permissionTemplateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilityNames, err := expandPermissionTemplate(state.ContentType.ValueString(), state.Template.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Expand Permission Template",
+			err.Error(),
+		)
+		return
+	}
+
+	mode := "Allow"
+	if !state.Mode.IsNull() {
+		mode = state.Mode.ValueString()
+	}
+
+	state.ID = types.StringValue(state.ContentType.ValueString() + "/" + state.Template.ValueString() + "/" + mode)
+	state.CapabilityNames = []types.String{}
+	state.Capabilities = []CapabilityModel{}
+	for _, capabilityName := range capabilityNames {
+		state.CapabilityNames = append(state.CapabilityNames, types.StringValue(capabilityName))
+		state.Capabilities = append(state.Capabilities, CapabilityModel{
+			Name: types.StringValue(capabilityName),
+			Mode: types.StringValue(mode),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
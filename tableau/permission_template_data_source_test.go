@@ -0,0 +1,30 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPermissionTemplateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                data "tableau_permission_template" "test" {
+                    content_type = "project"
+                    template = "Publish"
+                    mode = "Deny"
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tableau_permission_template.test", "id", "project/Publish/Deny"),
+					resource.TestCheckResourceAttr("data.tableau_permission_template.test", "capability_names.#", "2"),
+					resource.TestCheckResourceAttr("data.tableau_permission_template.test", "capabilities.0.name", "Read"),
+					resource.TestCheckResourceAttr("data.tableau_permission_template.test", "capabilities.0.mode", "Deny"),
+					resource.TestCheckResourceAttr("data.tableau_permission_template.test", "capabilities.1.name", "Write"),
+				),
+			},
+		},
+	})
+}
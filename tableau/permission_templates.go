@@ -0,0 +1,81 @@
+package tableau
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// permissionTemplateNames are the role style bundles Tableau offers when
+// editing permissions, each including every capability of the ones before it
+var permissionTemplateNames = []string{
+	"View",
+	"Explore",
+	"Publish",
+	"Administer",
+}
+
+// permissionTemplateAdditions lists, per content type, the capabilities each
+// template adds to the previous one
+var permissionTemplateAdditions = map[string]map[string][]string{
+	"project": {
+		"View":       {"Read"},
+		"Explore":    {},
+		"Publish":    {"Write"},
+		"Administer": {"ProjectLeader"},
+	},
+	"workbook": {
+		"View":       {"Read", "Filter", "ViewComments", "AddComment", "ExportImage", "ExportData"},
+		"Explore":    {"ShareView", "ViewUnderlyingData", "WebAuthoring", "RunExplainData"},
+		"Publish":    {"ExportXml", "Write", "CreateRefreshMetrics"},
+		"Administer": {"ChangeHierarchy", "Delete", "ChangePermissions"},
+	},
+	"datasource": {
+		"View":       {"Read", "Connect"},
+		"Explore":    {"ExportXml"},
+		"Publish":    {"Write", "SaveAs"},
+		"Administer": {"Delete", "ChangePermissions"},
+	},
+	"view": {
+		"View":       {"Read", "Filter", "ViewComments", "AddComment", "ExportImage", "ExportData"},
+		"Explore":    {"ShareView", "ViewUnderlyingData", "WebAuthoring"},
+		"Publish":    {"ExportXml", "Write"},
+		"Administer": {"Delete", "ChangePermissions"},
+	},
+	"virtual_connection": {
+		"View":       {"Read"},
+		"Explore":    {"Connect"},
+		"Publish":    {"Overwrite"},
+		"Administer": {"ChangeHierarchy", "Delete", "ChangePermissions"},
+	},
+}
+
+func permissionTemplateContentTypes() []string {
+	contentTypes := []string{}
+	for contentType := range permissionTemplateAdditions {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	return contentTypes
+}
+
+// expandPermissionTemplate returns the capabilities a template grants on a
+// type of content, sorted by name
+func expandPermissionTemplate(contentType, template string) ([]string, error) {
+	additions, ok := permissionTemplateAdditions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("unknown content type %s, expected one of %s", contentType, strings.Join(permissionTemplateContentTypes(), ", "))
+	}
+	last := slices.Index(permissionTemplateNames, template)
+	if last < 0 {
+		return nil, fmt.Errorf("unknown permission template %s, expected one of %s", template, strings.Join(permissionTemplateNames, ", "))
+	}
+
+	capabilities := []string{}
+	for _, name := range permissionTemplateNames[:last+1] {
+		capabilities = append(capabilities, additions[name]...)
+	}
+	sort.Strings(capabilities)
+	return capabilities, nil
+}
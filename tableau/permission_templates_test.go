@@ -0,0 +1,62 @@
+package tableau
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPermissionTemplatesUseValidCapabilities(t *testing.T) {
+	validCapabilities := map[string][]string{
+		"project":            projectCapabilities,
+		"workbook":           workbookCapabilities,
+		"datasource":         datasourceCapabilities,
+		"view":               viewCapabilities,
+		"virtual_connection": virtualConnectionCapabilities,
+	}
+	for _, contentType := range permissionTemplateContentTypes() {
+		previous := []string{}
+		for _, template := range permissionTemplateNames {
+			capabilities, err := expandPermissionTemplate(contentType, template)
+			if err != nil {
+				t.Fatalf("unexpected error expanding %s for %s: %s", template, contentType, err)
+			}
+			for _, capability := range capabilities {
+				if !slices.Contains(validCapabilities[contentType], capability) {
+					t.Errorf("%s template for %s grants %s, which is not a %s capability", template, contentType, capability, contentType)
+				}
+			}
+			for _, capability := range previous {
+				if !slices.Contains(capabilities, capability) {
+					t.Errorf("%s template for %s is missing %s from the template before it", template, contentType, capability)
+				}
+			}
+			previous = capabilities
+		}
+	}
+}
+
+func TestExpandPermissionTemplate(t *testing.T) {
+	capabilities, err := expandPermissionTemplate("datasource", "Explore")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"Connect", "ExportXml", "Read"}; !slices.Equal(capabilities, expected) {
+		t.Errorf("expected %v, got %v", expected, capabilities)
+	}
+
+	// as in the Tableau UI, viewing a data source includes connecting to it
+	capabilities, err = expandPermissionTemplate("datasource", "View")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"Connect", "Read"}; !slices.Equal(capabilities, expected) {
+		t.Errorf("expected %v, got %v", expected, capabilities)
+	}
+
+	if _, err := expandPermissionTemplate("flow", "View"); err == nil {
+		t.Errorf("expected an error for an unknown content type")
+	}
+	if _, err := expandPermissionTemplate("workbook", "Edit"); err == nil {
+		t.Errorf("expected an error for an unknown template")
+	}
+}
@@ -0,0 +1,256 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// PermissionRule is a single capability granted to or denied a user or group,
+// the unit permissions are added and deleted in
+type PermissionRule struct {
+	EntityType     string
+	EntityID       string
+	CapabilityName string
+	CapabilityMode string
+}
+
+func (r PermissionRule) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.EntityType, r.EntityID, r.CapabilityName, r.CapabilityMode)
+}
+
+func permissionsEndpoint(apiUrl, contentType, contentID string) string {
+	return fmt.Sprintf("%s/%s/%s/permissions", apiUrl, contentType, contentID)
+}
+
+// GetPermissions reads the explicit permissions of any content type served
+// under /{contentType}/{contentID}/permissions, e.g. projects or workbooks
+func (c *Client) GetPermissions(ctx context.Context, contentType, contentID string) (*ProjectPermissions, error) {
+	return c.getPermissions(ctx, permissionsEndpoint(c.ApiUrl, contentType, contentID))
+}
+
+// AddPermissions adds permissions to content, leaving those already granted
+// in place
+func (c *Client) AddPermissions(ctx context.Context, contentType, contentID string, permissions ProjectPermissions) (*ProjectPermissions, error) {
+	return c.addPermissions(ctx, permissionsEndpoint(c.ApiUrl, contentType, contentID), permissions)
+}
+
+func (c *Client) DeletePermission(ctx context.Context, contentType, contentID string, rule PermissionRule) error {
+	return c.deletePermission(ctx, permissionsEndpoint(c.ApiUrl, contentType, contentID), rule)
+}
+
+// SetPermissions makes the explicit permissions on content exactly match
+// desired, deleting any rule not in it before adding those missing
+func (c *Client) SetPermissions(ctx context.Context, contentType, contentID string, desired []PermissionRule) error {
+	return c.setPermissions(ctx, permissionsEndpoint(c.ApiUrl, contentType, contentID), desired, nil)
+}
+
+// getPermissions, addPermissions, deletePermission and setPermissions work
+// on any permissions endpoint, content and project default permissions
+// sharing the same request and response bodies
+
+func (c *Client) getPermissions(ctx context.Context, endpoint string) (*ProjectPermissions, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	permissionsResponse := ProjectPermissionsResponse{}
+	err = json.Unmarshal(body, &permissionsResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionsResponse.ProjectPermissions, nil
+}
+
+func (c *Client) addPermissions(ctx context.Context, endpoint string, permissions ProjectPermissions) (*ProjectPermissions, error) {
+	permissionsRequest := ProjectPermissionsRequest{
+		ProjectPermissions: permissions,
+	}
+
+	newPermissionsJson, err := json.Marshal(permissionsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", endpoint, strings.NewReader(string(newPermissionsJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(retryablePermissionUpdate(req))
+	if err != nil {
+		return nil, err
+	}
+
+	permissionsResponse := ProjectPermissionsResponse{}
+	err = json.Unmarshal(body, &permissionsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permissionsResponse.ProjectPermissions, nil
+}
+
+func (c *Client) deletePermission(ctx context.Context, endpoint string, rule PermissionRule) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/%s", endpoint, rule), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setPermissions deletes the rules not in desired before adding those
+// missing. When managed is set only the rules in it, and those granting a
+// desired capability in the other mode, are considered for deletion, leaving
+// every other rule in place.
+func (c *Client) setPermissions(ctx context.Context, endpoint string, desired, managed []PermissionRule) error {
+	permissions, err := c.getPermissions(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	current := permissionRules(permissions.GranteeCapabilities)
+	if managed != nil {
+		managed = append(append([]PermissionRule{}, managed...), oppositePermissionRules(desired)...)
+		current = intersectPermissionRules(current, managed)
+	}
+	add, remove := diffPermissionRules(current, desired)
+	for _, rule := range remove {
+		if err := c.deletePermission(ctx, endpoint, rule); err != nil {
+			return fmt.Errorf("unable to delete permission %s: %w", rule, err)
+		}
+	}
+	if len(add) == 0 {
+		return nil
+	}
+	_, err = c.addPermissions(ctx, endpoint, ProjectPermissions{
+		GranteeCapabilities: granteeCapabilitiesFromRules(add),
+	})
+	return err
+}
+
+// permissionRules flattens grantee capabilities into one rule per capability
+func permissionRules(granteeCapabilities []GranteeCapability) []PermissionRule {
+	rules := []PermissionRule{}
+	for _, granteeCapability := range granteeCapabilities {
+		entityType, entityID := "groups", ""
+		if granteeCapability.User != nil {
+			entityType, entityID = "users", granteeCapability.User.ID
+		} else if granteeCapability.Group != nil {
+			entityID = granteeCapability.Group.ID
+		}
+		for _, capability := range granteeCapability.Capabilities.Capabilities {
+			rules = append(rules, PermissionRule{
+				EntityType:     entityType,
+				EntityID:       entityID,
+				CapabilityName: capability.Name,
+				CapabilityMode: capability.Mode,
+			})
+		}
+	}
+	return rules
+}
+
+// granteeCapabilitiesFromRules groups rules by grantee, in a stable order
+func granteeCapabilitiesFromRules(rules []PermissionRule) []GranteeCapability {
+	sorted := append([]PermissionRule{}, rules...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+
+	granteeCapabilities := []GranteeCapability{}
+	for _, rule := range sorted {
+		last := len(granteeCapabilities) - 1
+		if last < 0 || !grantedTo(granteeCapabilities[last], rule.EntityType, rule.EntityID) {
+			granteeCapability := GranteeCapability{}
+			if rule.EntityType == "users" {
+				granteeCapability.User = &User{ID: rule.EntityID}
+			} else {
+				granteeCapability.Group = &Group{ID: rule.EntityID}
+			}
+			granteeCapabilities = append(granteeCapabilities, granteeCapability)
+			last++
+		}
+		granteeCapabilities[last].Capabilities.Capabilities = append(granteeCapabilities[last].Capabilities.Capabilities, Capability{
+			Name: rule.CapabilityName,
+			Mode: rule.CapabilityMode,
+		})
+	}
+	return granteeCapabilities
+}
+
+func grantedTo(granteeCapability GranteeCapability, entityType, entityID string) bool {
+	if entityType == "users" {
+		return granteeCapability.User != nil && granteeCapability.User.ID == entityID
+	}
+	return granteeCapability.Group != nil && granteeCapability.Group.ID == entityID
+}
+
+// diffPermissionRules returns the rules to add and delete so current matches
+// desired, a capability changing mode being deleted and added again
+func diffPermissionRules(current, desired []PermissionRule) (add, remove []PermissionRule) {
+	currentRules := map[PermissionRule]bool{}
+	for _, rule := range current {
+		currentRules[rule] = true
+	}
+	desiredRules := map[PermissionRule]bool{}
+	for _, rule := range desired {
+		desiredRules[rule] = true
+		if !currentRules[rule] {
+			add = append(add, rule)
+		}
+	}
+	for _, rule := range current {
+		if !desiredRules[rule] {
+			remove = append(remove, rule)
+		}
+	}
+	return add, remove
+}
+
+// oppositePermissionRules returns each rule with its mode flipped, as a
+// grantee cannot be both allowed and denied the same capability
+func oppositePermissionRules(rules []PermissionRule) []PermissionRule {
+	opposite := []PermissionRule{}
+	for _, rule := range rules {
+		switch rule.CapabilityMode {
+		case "Allow":
+			rule.CapabilityMode = "Deny"
+		case "Deny":
+			rule.CapabilityMode = "Allow"
+		default:
+			continue
+		}
+		opposite = append(opposite, rule)
+	}
+	return opposite
+}
+
+// intersectPermissionRules returns the rules in rules that are also in other
+func intersectPermissionRules(rules, other []PermissionRule) []PermissionRule {
+	otherRules := map[PermissionRule]bool{}
+	for _, rule := range other {
+		otherRules[rule] = true
+	}
+	intersection := []PermissionRule{}
+	for _, rule := range rules {
+		if otherRules[rule] {
+			intersection = append(intersection, rule)
+		}
+	}
+	return intersection
+}
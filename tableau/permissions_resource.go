@@ -0,0 +1,425 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &permissionsResource{}
+	_ resource.ResourceWithConfigure   = &permissionsResource{}
+	_ resource.ResourceWithImportState = &permissionsResource{}
+	_ resource.ResourceWithModifyPlan  = &permissionsResource{}
+)
+
+// capabilities that can be granted on each type of content
+var (
+	projectCapabilities = []string{
+		"ProjectLeader",
+		"Read",
+		"Write",
+	}
+	workbookCapabilities = []string{
+		"AddComment",
+		"ChangeHierarchy",
+		"ChangePermissions",
+		"CreateRefreshMetrics",
+		"Delete",
+		"ExportData",
+		"ExportImage",
+		"ExportXml",
+		"Filter",
+		"Read",
+		"RunExplainData",
+		"ShareView",
+		"ViewComments",
+		"ViewUnderlyingData",
+		"WebAuthoring",
+		"Write",
+	}
+	datasourceCapabilities = []string{
+		"ChangePermissions",
+		"Connect",
+		"Delete",
+		"ExportXml",
+		"Read",
+		"SaveAs",
+		"Write",
+	}
+	viewCapabilities = []string{
+		"AddComment",
+		"ChangePermissions",
+		"Delete",
+		"ExportData",
+		"ExportImage",
+		"ExportXml",
+		"Filter",
+		"Read",
+		"ShareView",
+		"ViewComments",
+		"ViewUnderlyingData",
+		"WebAuthoring",
+		"Write",
+	}
+	virtualConnectionCapabilities = []string{
+		"ChangeHierarchy",
+		"ChangePermissions",
+		"Connect",
+		"Delete",
+		"Overwrite",
+		"Read",
+	}
+)
+
+// permissionsResource authoritatively manages every explicit permission on a
+// single piece of content, unlike the *_permission resources managing one
+// rule each
+type permissionsResource struct {
+	client *Client
+	// contentType is the REST API path segment, e.g. "workbooks"
+	contentType string
+	// contentName names the content in the type name and attributes, e.g.
+	// "virtual_connection"
+	contentName string
+	// displayName names the content in descriptions, e.g. "virtual connection"
+	displayName  string
+	capabilities []string
+}
+
+func NewProjectPermissionsResource() resource.Resource {
+	return &permissionsResource{
+		contentType:  "projects",
+		contentName:  "project",
+		displayName:  "project",
+		capabilities: projectCapabilities,
+	}
+}
+
+func NewWorkbookPermissionsResource() resource.Resource {
+	return &permissionsResource{
+		contentType:  "workbooks",
+		contentName:  "workbook",
+		displayName:  "workbook",
+		capabilities: workbookCapabilities,
+	}
+}
+
+func NewDatasourcePermissionsResource() resource.Resource {
+	return &permissionsResource{
+		contentType:  "datasources",
+		contentName:  "datasource",
+		displayName:  "data source",
+		capabilities: datasourceCapabilities,
+	}
+}
+
+func NewViewPermissionsResource() resource.Resource {
+	return &permissionsResource{
+		contentType:  "views",
+		contentName:  "view",
+		displayName:  "view",
+		capabilities: viewCapabilities,
+	}
+}
+
+func NewVirtualConnectionPermissionsResource() resource.Resource {
+	return &permissionsResource{
+		contentType:  "virtualconnections",
+		contentName:  "virtual_connection",
+		displayName:  "virtual connection",
+		capabilities: virtualConnectionCapabilities,
+	}
+}
+
+func (r *permissionsResource) contentIDAttribute() string {
+	return r.contentName + "_id"
+}
+
+func (r *permissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.contentName + "_permissions"
+}
+
+func (r *permissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Authoritatively manage the explicit permissions on a %s, removing any rule granted outside of Terraform, "+
+			"so it must not be combined with tableau_%s_permission resources for the same %s", r.displayName, r.contentName, r.displayName),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the %s", r.displayName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			r.contentIDAttribute(): schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s to manage permissions on", r.displayName),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_capabilities": schema.SetNestedAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Every user and group granted capabilities on the %s, any other explicit permission is removed", r.displayName),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the user, exactly one of user_id and group_id is required",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("group_id")),
+							},
+						},
+						"group_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the group, exactly one of user_id and group_id is required",
+						},
+						"capabilities": schema.SetNestedAttribute{
+							Required:    true,
+							Description: "Capabilities of the user or group",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "Name of the capability, one of " + strings.Join(r.capabilities, "/"),
+										Validators: []validator.String{
+											stringvalidator.OneOf(r.capabilities...),
+										},
+									},
+									"mode": schema.StringAttribute{
+										Required:    true,
+										Description: "Capability mode, Allow or Deny (case sensitive)",
+										Validators: []validator.String{
+											stringvalidator.OneOf([]string{
+												"Allow",
+												"Deny",
+											}...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getContentID reads the content ID attribute, falling back to the ID after
+// an import
+func (r *permissionsResource) getContentID(ctx context.Context, data tfsdk.State) (string, diag.Diagnostics) {
+	var contentID, id types.String
+	diags := data.GetAttribute(ctx, path.Root(r.contentIDAttribute()), &contentID)
+	diags.Append(data.GetAttribute(ctx, path.Root("id"), &id)...)
+	if contentID.IsNull() {
+		return id.ValueString(), diags
+	}
+	return contentID.ValueString(), diags
+}
+
+func desiredPermissionRules(granteeCapabilities []GranteeCapabilityModel) ([]PermissionRule, error) {
+	rules := []PermissionRule{}
+	modes := map[string]string{}
+	for _, granteeCapability := range granteeCapabilities {
+		entityType, entityID := "groups", granteeCapability.GroupID.ValueString()
+		if !granteeCapability.UserID.IsNull() {
+			entityType, entityID = "users", granteeCapability.UserID.ValueString()
+		}
+		for _, capability := range granteeCapability.Capabilities {
+			key := entityType + "/" + entityID + "/" + capability.Name.ValueString()
+			if mode, ok := modes[key]; ok && mode != capability.Mode.ValueString() {
+				return nil, fmt.Errorf("capability %s is both allowed and denied for %s %s", capability.Name.ValueString(), entityType, entityID)
+			}
+			modes[key] = capability.Mode.ValueString()
+			rules = append(rules, PermissionRule{
+				EntityType:     entityType,
+				EntityID:       entityID,
+				CapabilityName: capability.Name.ValueString(),
+				CapabilityMode: capability.Mode.ValueString(),
+			})
+		}
+	}
+	return rules, nil
+}
+
+func granteeCapabilityModels(granteeCapabilities []GranteeCapability) []GranteeCapabilityModel {
+	models := []GranteeCapabilityModel{}
+	for _, granteeCapability := range granteeCapabilitiesFromRules(permissionRules(granteeCapabilities)) {
+		model := GranteeCapabilityModel{
+			UserID:  types.StringNull(),
+			GroupID: types.StringNull(),
+		}
+		if granteeCapability.User != nil {
+			model.UserID = types.StringValue(granteeCapability.User.ID)
+		} else {
+			model.GroupID = types.StringValue(granteeCapability.Group.ID)
+		}
+		for _, capability := range granteeCapability.Capabilities.Capabilities {
+			model.Capabilities = append(model.Capabilities, CapabilityModel{
+				Name: types.StringValue(capability.Name),
+				Mode: types.StringValue(capability.Mode),
+			})
+		}
+		models = append(models, model)
+	}
+	return models
+}
+
+func (r *permissionsResource) apply(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State) diag.Diagnostics {
+	var site, contentID types.String
+	var granteeCapabilities []GranteeCapabilityModel
+	diags := plan.GetAttribute(ctx, path.Root("site"), &site)
+	diags.Append(plan.GetAttribute(ctx, path.Root(r.contentIDAttribute()), &contentID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("grantee_capabilities"), &granteeCapabilities)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	client, clientDiags := r.client.forSite(ctx, site)
+	diags.Append(clientDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired, err := desiredPermissionRules(granteeCapabilities)
+	if err != nil {
+		diags.AddAttributeError(path.Root("grantee_capabilities"), "Invalid Grantee Capabilities", err.Error())
+		return diags
+	}
+
+	err = client.SetPermissions(ctx, r.contentType, contentID.ValueString(), desired)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Tableau Permissions",
+			"Could not set permissions on "+r.contentName+" ID "+contentID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	state.Raw = plan.Raw
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), contentID)...)
+	return diags
+}
+
+func (r *permissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.apply(ctx, req.Plan, &resp.State)...)
+}
+
+func (r *permissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var site types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("site"), &site)...)
+	contentID, diags := r.getContentID(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := client.GetPermissions(ctx, r.contentType, contentID)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Permissions",
+			"Could not read permissions on "+r.contentName+" ID "+contentID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), contentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.contentIDAttribute()), contentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("grantee_capabilities"), granteeCapabilityModels(permissions.GranteeCapabilities))...)
+}
+
+func (r *permissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.apply(ctx, req.Plan, &resp.State)...)
+}
+
+func (r *permissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var site types.String
+	var granteeCapabilities []GranteeCapabilityModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("site"), &site)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("grantee_capabilities"), &granteeCapabilities)...)
+	contentID, diags := r.getContentID(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the rules in state are removed, anything granted since the last
+	// refresh is left alone
+	rules, err := desiredPermissionRules(granteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Tableau Permissions", err.Error())
+		return
+	}
+	for _, rule := range rules {
+		err := client.DeletePermission(ctx, r.contentType, contentID, rule)
+		if err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Tableau Permissions",
+				"Could not delete permission "+rule.String()+" on "+r.contentName+" ID "+contentID+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ModifyPlan warns when virtual connection permissions are planned against a
+// server too old to support them
+func (r *permissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.contentType != "virtualconnections" {
+		return
+	}
+
+	var site types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, diags := r.client.forSite(ctx, site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+}
+
+func (r *permissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *permissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
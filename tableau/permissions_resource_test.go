@@ -0,0 +1,84 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectPermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_project_permissions"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_group" "test" {
+  name = "test_project_permissions"
+  minimum_site_role = "Viewer"
+}
+resource "tableau_project_permissions" "test" {
+  project_id = tableau_project.test.id
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.test.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Deny" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("tableau_project_permissions.test", "id", "tableau_project.test", "id"),
+					resource.TestCheckResourceAttr("tableau_project_permissions.test", "grantee_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("tableau_project_permissions.test", "grantee_capabilities.0.capabilities.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "tableau_project_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_project_permissions"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_group" "test" {
+  name = "test_project_permissions"
+  minimum_site_role = "Viewer"
+}
+resource "tableau_project_permissions" "test" {
+  project_id = tableau_project.test.id
+  grantee_capabilities = [
+    {
+      group_id = tableau_group.test.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project_permissions.test", "grantee_capabilities.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("tableau_project_permissions.test", "grantee_capabilities.0.capabilities.*", map[string]string{
+						"name": "Write",
+						"mode": "Allow",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDiffPermissionRules(t *testing.T) {
+	current := []PermissionRule{
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Read", CapabilityMode: "Allow"},
+		{EntityType: "users", EntityID: "intern", CapabilityName: "Write", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Allow"},
+	}
+	desired := []PermissionRule{
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Read", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Deny"},
+		{EntityType: "users", EntityID: "owner", CapabilityName: "ProjectLeader", CapabilityMode: "Allow"},
+	}
+
+	add, remove := diffPermissionRules(current, desired)
+	expectedAdd := []PermissionRule{
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Deny"},
+		{EntityType: "users", EntityID: "owner", CapabilityName: "ProjectLeader", CapabilityMode: "Allow"},
+	}
+	expectedRemove := []PermissionRule{
+		{EntityType: "users", EntityID: "intern", CapabilityName: "Write", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Allow"},
+	}
+	if !reflect.DeepEqual(add, expectedAdd) {
+		t.Errorf("expected to add %v, got %v", expectedAdd, add)
+	}
+	if !reflect.DeepEqual(remove, expectedRemove) {
+		t.Errorf("expected to remove %v, got %v", expectedRemove, remove)
+	}
+
+	if add, remove := diffPermissionRules(desired, desired); len(add) != 0 || len(remove) != 0 {
+		t.Errorf("expected no changes when permissions match, got %v to add and %v to remove", add, remove)
+	}
+}
+
+func TestGranteeCapabilitiesFromRules(t *testing.T) {
+	rules := []PermissionRule{
+		{EntityType: "users", EntityID: "owner", CapabilityName: "Write", CapabilityMode: "Allow"},
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Read", CapabilityMode: "Allow"},
+		{EntityType: "users", EntityID: "owner", CapabilityName: "Read", CapabilityMode: "Allow"},
+	}
+
+	granteeCapabilities := granteeCapabilitiesFromRules(rules)
+	if len(granteeCapabilities) != 2 {
+		t.Fatalf("expected rules grouped into 2 grantees, got %d", len(granteeCapabilities))
+	}
+	if granteeCapabilities[0].Group == nil || granteeCapabilities[0].Group.ID != "analysts" {
+		t.Errorf("expected the analysts group first, got %+v", granteeCapabilities[0])
+	}
+	if granteeCapabilities[1].User == nil || len(granteeCapabilities[1].Capabilities.Capabilities) != 2 {
+		t.Errorf("expected both of the owner's capabilities together, got %+v", granteeCapabilities[1])
+	}
+
+	if roundTrip := permissionRules(granteeCapabilities); len(roundTrip) != len(rules) {
+		t.Errorf("expected %d rules back, got %v", len(rules), roundTrip)
+	}
+}
+
+func TestSetDefaultPermissionsReplacesOppositeMode(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"permissions":{"granteeCapabilities":[
+				{"group":{"id":"analysts"},"capabilities":{"capability":[{"name":"Write","mode":"Allow"}]}},
+				{"user":{"id":"intern"},"capabilities":{"capability":[{"name":"Read","mode":"Allow"}]}}
+			]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"permissions":{"granteeCapabilities":[]}}`)
+	}))
+	t.Cleanup(server.Close)
+	c := testRetryClient(server)
+
+	desired := []PermissionRule{
+		{EntityType: "groups", EntityID: "analysts", CapabilityName: "Write", CapabilityMode: "Deny"},
+	}
+	err := c.SetDefaultPermissions(context.Background(), "project", "workbooks", desired, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	endpoint := "/projects/project/default-permissions/workbooks"
+	expected := []string{
+		"GET " + endpoint,
+		"DELETE " + endpoint + "/groups/analysts/Write/Allow",
+		"PUT " + endpoint,
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -34,107 +35,58 @@ type ProjectListResponse struct {
 	Pagination       PaginationDetails `json:"pagination"`
 }
 
-func (c *Client) GetProjects() ([]Project, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
-	}
+func (r ProjectListResponse) pageItems() []Project {
+	return r.ProjectsResponse.Projects
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+func (r ProjectListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
 
-	projectListResponse := ProjectListResponse{}
-	err = json.Unmarshal(body, &projectListResponse)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) GetProjects(ctx context.Context) ([]Project, error) {
+	return listAll[Project, ProjectListResponse](ctx, c, fmt.Sprintf("%s/projects", c.ApiUrl), nil)
+}
 
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(projectListResponse.Pagination)
-	if err != nil {
-		return nil, err
+// GetProject looks a project up by ID, the REST API has no single project
+// endpoint so when the name is known the listing is filtered down to it first,
+// only falling back to filtering by ID if it has since been renamed
+func (c *Client) GetProject(ctx context.Context, projectID, name string) (*Project, error) {
+	matchProject := func(project Project) bool {
+		return project.ID == projectID || (projectID == "" && project.Name == name)
 	}
-
-	allProjects := make([]Project, 0, totalAvailable)
-	allProjects = append(allProjects, projectListResponse.ProjectsResponse.Projects...)
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/projects?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
+	if name != "" {
+		query := NewListQuery().FilterEqualsOrScan("name", name)
+		project, err := findFirst[Project, ProjectListResponse](ctx, c, fmt.Sprintf("%s/projects", c.ApiUrl), query, matchProject)
 		if err != nil {
 			return nil, err
 		}
-		projectListResponse = ProjectListResponse{}
-		err = json.Unmarshal(body, &projectListResponse)
-		if err != nil {
-			return nil, err
+		if project != nil {
+			return project, nil
 		}
-		allProjects = append(allProjects, projectListResponse.ProjectsResponse.Projects...)
-	}
-
-	return allProjects, nil
-}
-
-func (c *Client) GetProject(projectID string) (*Project, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
 	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+	if projectID == "" {
+		return nil, fmt.Errorf("Did not find project named %s: %w", name, ErrNotFound)
 	}
 
-	projectListResponse := ProjectListResponse{}
-	err = json.Unmarshal(body, &projectListResponse)
-	if err != nil {
-		return nil, err
-	}
+	return c.getProjectByID(ctx, projectID)
+}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, _, err := GetPaginationNumbers(projectListResponse.Pagination)
+// getProjectByID fetches a single project, filtering the listing to its ID
+func (c *Client) getProjectByID(ctx context.Context, projectID string) (*Project, error) {
+	query := NewListQuery().Filter("id", FilterEquals, projectID)
+	project, err := findFirst[Project, ProjectListResponse](ctx, c, fmt.Sprintf("%s/projects", c.ApiUrl), query, func(project Project) bool {
+		return project.ID == projectID
+	})
 	if err != nil {
 		return nil, err
 	}
-	for i, project := range projectListResponse.ProjectsResponse.Projects {
-		if project.ID == projectID {
-			return &projectListResponse.ProjectsResponse.Projects[i], nil
-		}
+	if project == nil {
+		return nil, fmt.Errorf("Did not find project ID %s: %w", projectID, ErrNotFound)
 	}
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/projects?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		projectListResponse = ProjectListResponse{}
-		err = json.Unmarshal(body, &projectListResponse)
-		if err != nil {
-			return nil, err
-		}
-		// Check page for project match
-		for i, project := range projectListResponse.ProjectsResponse.Projects {
-			if project.ID == projectID {
-				return &projectListResponse.ProjectsResponse.Projects[i], nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("Did not find project ID %s", projectID)
+	return project, nil
 }
 
-func (c *Client) CreateProject(name, parentProjectId, description, contentPermissions, ownerId string) (*Project, error) {
+func (c *Client) CreateProject(ctx context.Context, name, parentProjectId, description, contentPermissions, ownerId string) (*Project, error) {
 
 	newProject := Project{
 		Name:               name,
@@ -157,7 +109,7 @@ func (c *Client) CreateProject(name, parentProjectId, description, contentPermis
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/projects", c.ApiUrl), strings.NewReader(string(newProjectJson)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/projects", c.ApiUrl), strings.NewReader(string(newProjectJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +129,7 @@ func (c *Client) CreateProject(name, parentProjectId, description, contentPermis
 	return &projectResponse.Project, nil
 }
 
-func (c *Client) UpdateProject(projectID, name, parentProjectId, description, contentPermissions, ownerId string) (*Project, error) {
+func (c *Client) UpdateProject(ctx context.Context, projectID, name, parentProjectId, description, contentPermissions, ownerId string) (*Project, error) {
 	newOwner := Owner{
 		ID: ownerId,
 	}
@@ -197,7 +149,7 @@ func (c *Client) UpdateProject(projectID, name, parentProjectId, description, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), strings.NewReader(string(newProjectJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), strings.NewReader(string(newProjectJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -216,9 +168,9 @@ func (c *Client) UpdateProject(projectID, name, parentProjectId, description, co
 	return &projectResponse.Project, nil
 }
 
-func (c *Client) DeleteProject(projectID string) error {
+func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), nil)
 	if err != nil {
 		return err
 	}
@@ -3,8 +3,11 @@ package tableau
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,6 +30,8 @@ type projectDataSourceModel struct {
 	Description        types.String `tfsdk:"description"`
 	ContentPermissions types.String `tfsdk:"content_permissions"`
 	ParentProjectID    types.String `tfsdk:"parent_project_id"`
+	Path               types.String `tfsdk:"path"`
+	Site               types.String `tfsdk:"site"`
 }
 
 func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,9 +43,19 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		Description: "Retrieve project details",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the project",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project, exactly one of id and path is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("path")),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Path of the project from the top level down, e.g. Finance/Reporting/Monthly, with any / in a project name escaped as \\/, exactly one of id and path is required",
 			},
+			"site": dataSourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Computed:    true,
 				Description: "Name for the project",
@@ -66,7 +81,24 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	project, err := d.client.GetProject(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *Project
+	var err error
+	if !state.Path.IsNull() {
+		project, err = client.GetProjectByPath(ctx, state.Path.ValueString())
+	} else {
+		project, err = client.GetProject(ctx, state.ID.ValueString(), "")
+		if err == nil {
+			var projectPath string
+			projectPath, err = client.GetProjectPath(ctx, *project)
+			state.Path = types.StringValue(projectPath)
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project",
@@ -81,7 +113,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	state.ContentPermissions = types.StringValue(project.ContentPermissions)
 	state.ParentProjectID = types.StringValue(project.ParentProjectID)
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
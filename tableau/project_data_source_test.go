@@ -17,13 +17,25 @@ func TestAccProjectDataSource(t *testing.T) {
 									description = "Test project for data source test"
 									content_permissions = "ManagedByOwner"
 								}
+								resource "tableau_project" "child" {
+									name = "test_project_data_source_child"
+									parent_project_id = tableau_project.test.id
+									content_permissions = "ManagedByOwner"
+								}
                 data "tableau_project" "test" {
                     id = tableau_project.test.id
+                }
+                data "tableau_project" "by_path" {
+                    path = "test_project_data_source/test_project_data_source_child"
+                    depends_on = [tableau_project.child]
                 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.tableau_project.test", "name", "test_project_data_source"),
 					resource.TestCheckResourceAttr("data.tableau_project.test", "content_permissions", "ManagedByOwner"),
 					resource.TestCheckResourceAttr("data.tableau_project.test", "description", "Test project for data source test"),
+					resource.TestCheckResourceAttr("data.tableau_project.test", "path", "test_project_data_source"),
+					resource.TestCheckResourceAttrPair("data.tableau_project.by_path", "id", "tableau_project.child", "id"),
+					resource.TestCheckResourceAttrPair("data.tableau_project.by_path", "parent_project_id", "tableau_project.test", "id"),
 				),
 			},
 		},
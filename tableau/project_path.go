@@ -0,0 +1,188 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// projectPathSeparator separates the project names in a path such as
+// Finance/Reporting/Monthly, a name containing it being escaped with a
+// backslash, e.g. Sales\/Marketing
+const projectPathSeparator = "/"
+
+// splitProjectPath returns the project names in a path, top level first
+func splitProjectPath(path string) ([]string, error) {
+	names := []string{}
+	var name strings.Builder
+	escaped := false
+	for _, r := range path {
+		switch {
+		case escaped:
+			name.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case string(r) == projectPathSeparator:
+			names = append(names, name.String())
+			name.Reset()
+		default:
+			name.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("project path %q ends in an escape", path)
+	}
+	names = append(names, name.String())
+	if slices.Contains(names, "") {
+		return nil, fmt.Errorf("project path %q has an empty project name", path)
+	}
+	return names, nil
+}
+
+// joinProjectPath builds a path from project names, escaping any separator
+// in them
+func joinProjectPath(names []string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		name = strings.ReplaceAll(name, `\`, `\\`)
+		escaped[i] = strings.ReplaceAll(name, projectPathSeparator, `\`+projectPathSeparator)
+	}
+	return strings.Join(escaped, projectPathSeparator)
+}
+
+// projectAncestry returns the names of each project and the projects above
+// it, top level first, following the parent project IDs
+func projectAncestry(projects []Project) map[string][]string {
+	projectsByID := map[string]Project{}
+	for _, project := range projects {
+		projectsByID[project.ID] = project
+	}
+
+	ancestry := map[string][]string{}
+	for _, project := range projects {
+		names := []string{}
+		seen := map[string]bool{}
+		for id := project.ID; id != "" && !seen[id]; id = projectsByID[id].ParentProjectID {
+			seen[id] = true
+			parent, ok := projectsByID[id]
+			if !ok {
+				break
+			}
+			names = append(names, parent.Name)
+		}
+		slices.Reverse(names)
+		ancestry[project.ID] = names
+	}
+	return ancestry
+}
+
+// projectPaths returns the path of every project by ID
+func projectPaths(projects []Project) map[string]string {
+	paths := map[string]string{}
+	for id, names := range projectAncestry(projects) {
+		paths[id] = joinProjectPath(names)
+	}
+	return paths
+}
+
+// GetProjectPath returns the path of a project from the top level down
+func (c *Client) GetProjectPath(ctx context.Context, project Project) (string, error) {
+	chain, err := c.projectChain(ctx, project)
+	if err != nil {
+		return "", err
+	}
+	names := []string{}
+	for _, project := range chain {
+		names = append(names, project.Name)
+	}
+	slices.Reverse(names)
+	return joinProjectPath(names), nil
+}
+
+// projectChain returns a project followed by each project above it, fetching
+// them one at a time with the listing filtered to the parent's ID
+func (c *Client) projectChain(ctx context.Context, project Project) ([]Project, error) {
+	chain := []Project{project}
+	visited := map[string]bool{project.ID: true}
+	for parentProjectID := project.ParentProjectID; parentProjectID != "" && !visited[parentProjectID]; {
+		visited[parentProjectID] = true
+		parent, err := c.getProjectByID(ctx, parentProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the parent of project %s: %w", project.Name, err)
+		}
+		chain = append(chain, *parent)
+		parentProjectID = parent.ParentProjectID
+	}
+	return chain, nil
+}
+
+// GetProjectByPath resolves a path such as Finance/Reporting/Monthly one
+// level at a time, filtering the listing down to the projects of each name
+// beneath the one before
+func (c *Client) GetProjectByPath(ctx context.Context, path string) (*Project, error) {
+	names, err := splitProjectPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var project *Project
+	for i, name := range names {
+		parentProjectID := ""
+		query := NewListQuery().FilterEqualsOrScan("name", name)
+		if project == nil {
+			query.Filter("topLevelProject", FilterEquals, "true")
+		} else {
+			parentProjectID = project.ID
+			query.Filter("parentProjectId", FilterEquals, parentProjectID)
+		}
+		project, err = findFirst[Project, ProjectListResponse](ctx, c, fmt.Sprintf("%s/projects", c.ApiUrl), query, func(project Project) bool {
+			return project.Name == name && project.ParentProjectID == parentProjectID
+		})
+		if err != nil {
+			return nil, err
+		}
+		if project == nil {
+			return nil, fmt.Errorf("Did not find project %s in path %s: %w", joinProjectPath(names[:i+1]), path, ErrNotFound)
+		}
+	}
+	return project, nil
+}
+
+// projectTreeNode is a project with where it sits in the hierarchy
+type projectTreeNode struct {
+	Project         Project
+	Path            string
+	Depth           int
+	ChildProjectIDs []string
+	names           []string
+}
+
+// projectTree orders projects depth first, each project followed by the
+// projects beneath it, siblings sorted by name
+func projectTree(projects []Project) []projectTreeNode {
+	ancestry := projectAncestry(projects)
+	children := map[string][]string{}
+	nodes := []projectTreeNode{}
+	for _, project := range projects {
+		children[project.ParentProjectID] = append(children[project.ParentProjectID], project.ID)
+		nodes = append(nodes, projectTreeNode{
+			Project: project,
+			Path:    joinProjectPath(ancestry[project.ID]),
+			Depth:   len(ancestry[project.ID]) - 1,
+			names:   ancestry[project.ID],
+		})
+	}
+	for i := range nodes {
+		nodes[i].ChildProjectIDs = append([]string{}, children[nodes[i].Project.ID]...)
+		slices.Sort(nodes[i].ChildProjectIDs)
+	}
+	slices.SortStableFunc(nodes, func(a, b projectTreeNode) int {
+		if order := slices.Compare(a.names, b.names); order != 0 {
+			return order
+		}
+		return strings.Compare(a.Project.ID, b.Project.ID)
+	})
+	return nodes
+}
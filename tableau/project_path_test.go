@@ -0,0 +1,117 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitProjectPath(t *testing.T) {
+	cases := map[string][]string{
+		"Finance":                   {"Finance"},
+		"Finance/Reporting/Monthly": {"Finance", "Reporting", "Monthly"},
+		`Sales\/Marketing/Leads`:    {"Sales/Marketing", "Leads"},
+		`Back\\slash/Child`:         {`Back\slash`, "Child"},
+	}
+	for path, expected := range cases {
+		names, err := splitProjectPath(path)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", path, err)
+			continue
+		}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("%s: expected %q, got %q", path, expected, names)
+		}
+		if joined := joinProjectPath(names); joined != path {
+			t.Errorf("expected %q to join back to %q, got %q", names, path, joined)
+		}
+	}
+
+	for _, path := range []string{"", "/Finance", "Finance/", "Finance//Reporting", `Finance\`} {
+		if _, err := splitProjectPath(path); err == nil {
+			t.Errorf("expected an error splitting %q", path)
+		}
+	}
+}
+
+func TestProjectTree(t *testing.T) {
+	projects := []Project{
+		{ID: "monthly", Name: "Monthly", ParentProjectID: "reporting"},
+		{ID: "sales", Name: "Sales"},
+		{ID: "reporting", Name: "Reporting", ParentProjectID: "finance"},
+		{ID: "finance", Name: "Finance"},
+		{ID: "archive", Name: "Archive", ParentProjectID: "finance"},
+	}
+
+	paths := projectPaths(projects)
+	if paths["monthly"] != "Finance/Reporting/Monthly" {
+		t.Errorf("expected Finance/Reporting/Monthly, got %s", paths["monthly"])
+	}
+
+	tree := projectTree(projects)
+	expected := []struct {
+		id    string
+		depth int
+	}{
+		{"finance", 0},
+		{"archive", 1},
+		{"reporting", 1},
+		{"monthly", 2},
+		{"sales", 0},
+	}
+	if len(tree) != len(expected) {
+		t.Fatalf("expected %d projects, got %d", len(expected), len(tree))
+	}
+	for i, e := range expected {
+		if tree[i].Project.ID != e.id || tree[i].Depth != e.depth {
+			t.Errorf("position %d: expected %s at depth %d, got %s at depth %d", i, e.id, e.depth, tree[i].Project.ID, tree[i].Depth)
+		}
+	}
+	if !reflect.DeepEqual(tree[0].ChildProjectIDs, []string{"archive", "reporting"}) {
+		t.Errorf("expected finance to have archive and reporting beneath it, got %v", tree[0].ChildProjectIDs)
+	}
+}
+
+func TestGetProjectPath(t *testing.T) {
+	projects := map[string]string{
+		"finance":   `{"id":"finance","name":"Finance"}`,
+		"reporting": `{"id":"reporting","name":"Reporting/Ops","parentProjectId":"finance"}`,
+	}
+	filters := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		filters = append(filters, filter)
+		project, ok := projects[strings.TrimPrefix(filter, "id:eq:")]
+		if !strings.HasPrefix(filter, "id:eq:") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		total := 0
+		if ok {
+			total = 1
+		}
+		fmt.Fprintf(w, `{"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"%d"},"projects":{"project":[%s]}}`, total, project)
+	}))
+	t.Cleanup(server.Close)
+	c := &Client{ApiUrl: server.URL, HTTPClient: server.Client()}
+
+	path, err := c.GetProjectPath(context.Background(), Project{ID: "monthly", Name: "Monthly", ParentProjectID: "reporting"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := `Finance/Reporting\/Ops/Monthly`; path != expected {
+		t.Errorf("expected path %s, got %s", expected, path)
+	}
+	if expected := []string{"id:eq:reporting", "id:eq:finance"}; !reflect.DeepEqual(filters, expected) {
+		t.Errorf("expected one filtered lookup per ancestor %v, got %v", expected, filters)
+	}
+
+	_, err = c.GetProjectPath(context.Background(), Project{ID: "orphan", Name: "Orphan", ParentProjectID: "deleted"})
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "deleted") {
+		t.Errorf("expected the missing parent to be not found, got %v", err)
+	}
+}
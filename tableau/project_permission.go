@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -42,8 +43,8 @@ type ProjectPermissionsResponse struct {
 	ProjectPermissions ProjectPermissions `json:"permissions"`
 }
 
-func (c *Client) GetProjectPermission(projectID, entityID, entityType, capabilityName, capabilityMode string) (*ProjectPermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), nil)
+func (c *Client) GetProjectPermission(ctx context.Context, projectID, entityID, entityType, capabilityName, capabilityMode string) (*ProjectPermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,10 +89,10 @@ func (c *Client) GetProjectPermission(projectID, entityID, entityType, capabilit
 			}
 		}
 	}
-	return nil, nil
+	return nil, fmt.Errorf("Did not find %s %s permission for %s ID %s on project ID %s: %w", capabilityMode, capabilityName, entityType, entityID, projectID, ErrNotFound)
 }
 
-func (c *Client) CreateProjectPermissions(projectID string, projectPermissions ProjectPermissions) (*ProjectPermissions, error) {
+func (c *Client) CreateProjectPermissions(ctx context.Context, projectID string, projectPermissions ProjectPermissions) (*ProjectPermissions, error) {
 
 	projectPermissionsRequest := ProjectPermissionsRequest{
 		ProjectPermissions: projectPermissions,
@@ -102,12 +103,12 @@ func (c *Client) CreateProjectPermissions(projectID string, projectPermissions P
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), strings.NewReader(string(newProjectPermissionsJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), strings.NewReader(string(newProjectPermissionsJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doRequest(retryablePermissionUpdate(req))
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +122,7 @@ func (c *Client) CreateProjectPermissions(projectID string, projectPermissions P
 	return &projectPermissionsResponse.ProjectPermissions, nil
 }
 
-func (c *Client) DeleteProjectPermission(userID, groupID *string, projectID, capabilityName, capabilityMode string) error {
+func (c *Client) DeleteProjectPermission(ctx context.Context, userID, groupID *string, projectID, capabilityName, capabilityMode string) error {
 	var entityID string
 	entityType := "users"
 	if userID != nil {
@@ -131,7 +132,7 @@ func (c *Client) DeleteProjectPermission(userID, groupID *string, projectID, cap
 		entityID = *groupID
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/permissions/%s/%s/%s/%s", c.ApiUrl, projectID, entityType, entityID, capabilityName, capabilityMode), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/projects/%s/permissions/%s/%s/%s/%s", c.ApiUrl, projectID, entityType, entityID, capabilityName, capabilityMode), nil)
 
 	if err != nil {
 		return err
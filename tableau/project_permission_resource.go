@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,6 +36,7 @@ type projectPermissionResourceModel struct {
 	GroupID        types.String `tfsdk:"group_id"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
+	Site           types.String `tfsdk:"site"`
 }
 
 func (r *projectPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +52,7 @@ func (r *projectPermissionResource) Schema(_ context.Context, _ resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"project_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Project ID",
@@ -112,6 +113,12 @@ func (r *projectPermissionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := plan.ProjectID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -137,7 +144,7 @@ func (r *projectPermissionResource) Create(ctx context.Context, req resource.Cre
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateProjectPermissions(projectID, projectPermissions)
+	_, err := client.CreateProjectPermissions(ctx, projectID, projectPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project permission",
@@ -163,6 +170,12 @@ func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission, err := getProjectPermissionFromID(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -171,9 +184,16 @@ func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadR
 		)
 		return
 	}
-	projectPermission, err := r.client.GetProjectPermission(permission.ProjectID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	projectPermission, err := client.GetProjectPermission(ctx, permission.ProjectID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project Permission",
+			"Could not read Tableau project permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -217,6 +237,12 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission, err := getProjectPermissionFromID(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -226,7 +252,7 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 	if permission.EntityType == "users" {
-		err := r.client.DeleteProjectPermission(&permission.EntityID, nil, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteProjectPermission(ctx, &permission.EntityID, nil, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Project",
@@ -235,7 +261,7 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 			return
 		}
 	} else {
-		err := r.client.DeleteProjectPermission(nil, &permission.EntityID, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteProjectPermission(ctx, nil, &permission.EntityID, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Project",
@@ -255,7 +281,7 @@ func (r *projectPermissionResource) Configure(_ context.Context, req resource.Co
 }
 
 func (r *projectPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
 
 func getProjectPermissionID(projectID, entityType, entityID, capabilityName, capabilityMode string) string {
@@ -1,13 +1,14 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetProjectPermissions(projectID string) (*ProjectPermissions, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), nil)
+func (c *Client) GetProjectPermissions(ctx context.Context, projectID string) (*ProjectPermissions, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID), nil)
 	if err != nil {
 		return nil, err
 	}
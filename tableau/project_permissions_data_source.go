@@ -24,6 +24,7 @@ type projectPermissionsDataSource struct {
 type projectPermissionsDataSourceModel struct {
 	ID                  types.String             `tfsdk:"id"`
 	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+	Site                types.String             `tfsdk:"site"`
 }
 
 func (d *projectPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -38,6 +39,7 @@ func (d *projectPermissionsDataSource) Schema(_ context.Context, _ datasource.Sc
 				Required:    true,
 				Description: "ID of the project",
 			},
+			"site": dataSourceSiteAttribute(),
 			"grantee_capabilities": schema.ListNestedAttribute{
 				Description: "List of grantee capabilities for users and groups",
 				Computed:    true,
@@ -79,7 +81,13 @@ func (d *projectPermissionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	perms, err := d.client.GetProjectPermissions(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, err := client.GetProjectPermissions(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project Permissions",
@@ -109,7 +117,7 @@ func (d *projectPermissionsDataSource) Read(ctx context.Context, req datasource.
 			Capabilities: newCapabilities,
 		})
 	}
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
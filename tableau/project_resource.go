@@ -4,8 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,20 +30,23 @@ type projectResource struct {
 }
 
 type projectResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	ParentProjectID    types.String `tfsdk:"parent_project_id"`
-	Description        types.String `tfsdk:"description"`
-	ContentPermissions types.String `tfsdk:"content_permissions"`
-	OwnerID            types.String `tfsdk:"owner_id"`
-	LastUpdated        types.String `tfsdk:"last_updated"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	ParentProjectID    types.String   `tfsdk:"parent_project_id"`
+	Description        types.String   `tfsdk:"description"`
+	ContentPermissions types.String   `tfsdk:"content_permissions"`
+	OwnerID            types.String   `tfsdk:"owner_id"`
+	Path               types.String   `tfsdk:"path"`
+	LastUpdated        types.String   `tfsdk:"last_updated"`
+	Site               types.String   `tfsdk:"site"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
 
-func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *projectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -52,6 +55,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Display name for project",
@@ -85,6 +89,10 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path of the project from the top level down, e.g. Finance/Reporting/Monthly, with any / in a project name escaped as \\/",
+			},
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of the last Terraform update of the project",
@@ -93,6 +101,14 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -104,6 +120,20 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	project := Project{
 		Name:               plan.Name.ValueString(),
 		Description:        plan.Description.ValueString(),
@@ -112,7 +142,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		Owner:              Owner{ID: plan.OwnerID.ValueString()},
 	}
 
-	createdProject, err := r.client.CreateProject(project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
+	createdProject, err := client.CreateProject(ctx, project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project",
@@ -121,8 +151,18 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	projectPath, err := client.GetProjectPath(ctx, *createdProject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project",
+			"Could not read path of Tableau project ID "+createdProject.ID+": "+err.Error(),
+		)
+		return
+	}
+
 	plan.ID = types.StringValue(createdProject.ID)
 	plan.OwnerID = types.StringValue(createdProject.Owner.ID)
+	plan.Path = types.StringValue(projectPath)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -140,14 +180,45 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.client.GetProject(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := client.GetProject(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project",
+			"Could not read Tableau project ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	projectPath, err := client.GetProjectPath(ctx, *project)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project",
+			"Could not read path of Tableau project ID "+project.ID+": "+err.Error(),
+		)
 		return
 	}
 
 	state.ID = types.StringValue(project.ID)
 	state.Name = types.StringValue(project.Name)
+	state.Path = types.StringValue(projectPath)
 	if project.ParentProjectID != "" {
 		state.ParentProjectID = types.StringValue(project.ParentProjectID)
 	}
@@ -170,6 +241,20 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	project := Project{
 		Name:               plan.Name.ValueString(),
 		Description:        plan.Description.ValueString(),
@@ -177,7 +262,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		ParentProjectID:    plan.ParentProjectID.ValueString(),
 		Owner:              Owner{ID: plan.OwnerID.ValueString()},
 	}
-	_, err := r.client.UpdateProject(plan.ID.ValueString(), project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
+	_, err := client.UpdateProject(ctx, plan.ID.ValueString(), project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Project",
@@ -186,7 +271,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updatedProject, err := r.client.GetProject(plan.ID.ValueString())
+	updatedProject, err := client.GetProject(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Project",
@@ -195,7 +280,17 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	projectPath, err := client.GetProjectPath(ctx, *updatedProject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project",
+			"Could not read path of Tableau project ID "+updatedProject.ID+": "+err.Error(),
+		)
+		return
+	}
+
 	plan.Name = types.StringValue(updatedProject.Name)
+	plan.Path = types.StringValue(projectPath)
 	if project.ParentProjectID != "" {
 		plan.ParentProjectID = types.StringValue(updatedProject.ParentProjectID)
 	}
@@ -219,7 +314,21 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteProject(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteProject(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Project",
@@ -238,5 +347,5 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
@@ -65,6 +65,7 @@ resource "tableau_project" "test" {
 					resource.TestCheckResourceAttrSet("tableau_project.test", "parent_project_id"),
 					resource.TestCheckResourceAttrSet("tableau_project.test", "owner_id"),
 					resource.TestCheckResourceAttr("tableau_project.test", "name", "test_project_resource"),
+					resource.TestCheckResourceAttr("tableau_project.test", "path", "test_project_resource_parent/test_project_resource"),
 					resource.TestCheckResourceAttr("tableau_project.test", "description", "Moo"),
 					resource.TestCheckResourceAttr("tableau_project.test", "content_permissions", "LockedToProject"),
 				),
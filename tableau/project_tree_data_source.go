@@ -0,0 +1,154 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &projectTreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectTreeDataSource{}
+)
+
+func ProjectTreeDataSource() datasource.DataSource {
+	return &projectTreeDataSource{}
+}
+
+type projectTreeDataSource struct {
+	client *Client
+}
+
+type projectTreeNestedDataModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	ParentProjectID    types.String   `tfsdk:"parent_project_id"`
+	ContentPermissions types.String   `tfsdk:"content_permissions"`
+	Path               types.String   `tfsdk:"path"`
+	Depth              types.Int64    `tfsdk:"depth"`
+	ChildProjectIDs    []types.String `tfsdk:"child_project_ids"`
+}
+
+type projectTreeDataSourceModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	Projects         []projectTreeNestedDataModel `tfsdk:"projects"`
+	ProjectIDsByPath map[string]types.String      `tfsdk:"project_ids_by_path"`
+	Site             types.String                 `tfsdk:"site"`
+}
+
+func (d *projectTreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tree"
+}
+
+func (d *projectTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the whole project hierarchy, with the path and depth of each project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the project tree",
+			},
+			"site": dataSourceSiteAttribute(),
+			"projects": schema.ListNestedAttribute{
+				Description: "Projects in depth first order, each followed by the projects beneath it and siblings sorted by name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Project ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Project name",
+							Computed:    true,
+						},
+						"parent_project_id": schema.StringAttribute{
+							Description: "Parent project ID",
+							Computed:    true,
+						},
+						"content_permissions": schema.StringAttribute{
+							Description: "Permissions for the project content",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "Project path from the top level down, e.g. Finance/Reporting/Monthly, with any / in a project name escaped as \\/",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Depth of the project, top level projects being at depth 0",
+							Computed:    true,
+						},
+						"child_project_ids": schema.ListAttribute{
+							Description: "IDs of the projects directly beneath the project",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"project_ids_by_path": schema.MapAttribute{
+				Description: "Project IDs keyed by project path",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *projectTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectTreeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := client.GetProjects(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Projects",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Projects = []projectTreeNestedDataModel{}
+	state.ProjectIDsByPath = map[string]types.String{}
+	for _, node := range projectTree(projects) {
+		childProjectIDs := []types.String{}
+		for _, id := range node.ChildProjectIDs {
+			childProjectIDs = append(childProjectIDs, types.StringValue(id))
+		}
+		state.Projects = append(state.Projects, projectTreeNestedDataModel{
+			ID:                 types.StringValue(node.Project.ID),
+			Name:               types.StringValue(node.Project.Name),
+			ParentProjectID:    types.StringValue(node.Project.ParentProjectID),
+			ContentPermissions: types.StringValue(node.Project.ContentPermissions),
+			Path:               types.StringValue(node.Path),
+			Depth:              types.Int64Value(int64(node.Depth)),
+			ChildProjectIDs:    childProjectIDs,
+		})
+		state.ProjectIDsByPath[node.Path] = types.StringValue(node.Project.ID)
+	}
+
+	state.ID = types.StringValue("projectTree")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *projectTreeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
@@ -0,0 +1,40 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectTreeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tableau_project" "parent" {
+  name = "test_project_tree"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_project" "child" {
+  name = "test_project_tree_child"
+  parent_project_id = tableau_project.parent.id
+  content_permissions = "ManagedByOwner"
+}
+data "tableau_project_tree" "test" {
+  depends_on = [tableau_project.child]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.tableau_project_tree.test", "project_ids_by_path.test_project_tree/test_project_tree_child", "tableau_project.child", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_project_tree.test", "projects.*", map[string]string{
+						"name":  "test_project_tree_child",
+						"path":  "test_project_tree/test_project_tree_child",
+						"depth": "1",
+					}),
+					resource.TestCheckResourceAttr("tableau_project.child", "path", "test_project_tree/test_project_tree_child"),
+				),
+			},
+		},
+	})
+}
@@ -25,11 +25,13 @@ type projectsNestedDataModel struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	ParentProjectID types.String `tfsdk:"parent_project_id"`
+	Path            types.String `tfsdk:"path"`
 }
 
 type projectsDataSourceModel struct {
 	ID       types.String              `tfsdk:"id"`
 	Projects []projectsNestedDataModel `tfsdk:"projects"`
+	Site     types.String              `tfsdk:"site"`
 }
 
 func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +46,7 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 				Description: "ID of the list of projects",
 			},
+			"site": dataSourceSiteAttribute(),
 			"projects": schema.ListNestedAttribute{
 				Description: "List of projects and their attributes",
 				Computed:    true,
@@ -61,6 +64,10 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Description: "Parent project ID",
 							Computed:    true,
 						},
+						"path": schema.StringAttribute{
+							Description: "Project path from the top level down, e.g. Finance/Reporting/Monthly",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -73,7 +80,13 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	projects, err := d.client.GetProjects()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := client.GetProjects(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Projects",
@@ -82,18 +95,20 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	paths := projectPaths(projects)
 	for _, project := range projects {
 		projectDataSourceModel := projectsNestedDataModel{
 			ID:              types.StringValue(project.ID),
 			Name:            types.StringValue(project.Name),
 			ParentProjectID: types.StringValue(project.ParentProjectID),
+			Path:            types.StringValue(paths[project.ID]),
 		}
 		state.Projects = append(state.Projects, projectDataSourceModel)
 	}
 
 	state.ID = types.StringValue("allProjects")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
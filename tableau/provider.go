@@ -2,13 +2,20 @@ package tableau
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -37,7 +44,7 @@ func (p *tableauProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 			},
 			"server_version": schema.StringAttribute{
 				Optional:    true,
-				Description: "Version of the server identified in URL - TABLEAU_SERVER_VERSION env var",
+				Description: "REST API version used in request URLs e.g. 3.21, defaults to the highest version the server supports, read from serverinfo - TABLEAU_SERVER_VERSION env var",
 			},
 			"username": schema.StringAttribute{
 				Optional:    true,
@@ -57,10 +64,87 @@ func (p *tableauProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: "Personal access token secret - TABLEAU_PERSONAL_ACCESS_TOKEN_SECRET env var",
 			},
+			"connected_app_client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Client ID of a Tableau Connected App using direct trust, signs in with a JWT instead of a password or personal access token - TABLEAU_CONNECTED_APP_CLIENT_ID env var",
+			},
+			"connected_app_secret_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Secret ID of the Connected App - TABLEAU_CONNECTED_APP_SECRET_ID env var",
+			},
+			"connected_app_secret_value": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secret value of the Connected App, used to sign the JWT - TABLEAU_CONNECTED_APP_SECRET_VALUE env var",
+			},
+			"connected_app_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username of the Tableau user the Connected App signs in as - TABLEAU_CONNECTED_APP_USERNAME env var",
+			},
+			"connected_app_scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes granted to the Connected App JWT, defaults to read access to content plus full access to the content types the provider manages - TABLEAU_CONNECTED_APP_SCOPES env var as a comma separated list",
+			},
 			"site": schema.StringAttribute{
 				Optional:    true,
 				Description: "Site name from your Tableau URL - TABLEAU_SITE_NAME env var - for Tableau Server default sites leave as ''",
 			},
+			"page_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of items requested per page by list calls, between 1 and 1000, defaults to 100 - TABLEAU_PAGE_SIZE env var",
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxPageSize),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time limit for each individual HTTP request to Tableau, as a duration e.g. 2m, defaults to 10s. Requests sending a file being published are only limited by the resource timeouts - TABLEAU_REQUEST_TIMEOUT env var",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Total attempts made for a rate limited or transiently failing request, defaults to 4 - TABLEAU_RETRY_MAX_ATTEMPTS env var",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Wait before the first retry, doubling on each subsequent retry, as a duration e.g. 500ms, defaults to 1s - TABLEAU_RETRY_MIN_BACKOFF env var",
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "Longest wait between retries, also capping any Retry-After sent by the server, defaults to 30s - TABLEAU_RETRY_MAX_BACKOFF env var",
+			},
+			"retry_jitter": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Randomise the wait between retries to spread out concurrent requests, defaults to true - TABLEAU_RETRY_JITTER env var",
+			},
+			"retry_permission_updates": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Also retry the PUT requests that grant permissions, only GET requests are retried on server errors by default - TABLEAU_RETRY_PERMISSION_UPDATES env var",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificate, or the path to one, trusted in addition to the system CAs e.g. for a server using an internal CA - TABLEAU_CA_CERTIFICATE env var",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate, or the path to one, presented to the server and any HTTPS proxy requiring mutual TLS - TABLEAU_CLIENT_CERTIFICATE env var",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key for the client certificate, or the path to one - TABLEAU_CLIENT_KEY env var",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verifying the server's certificate, only intended for lab environments - TABLEAU_INSECURE_SKIP_VERIFY env var",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy to send requests through e.g. http://proxy.example.com:8080, defaults to the HTTPS_PROXY, HTTP_PROXY and NO_PROXY env vars - TABLEAU_PROXY_URL env var",
+			},
 		},
 	}
 }
@@ -72,7 +156,24 @@ type tableauProviderModel struct {
 	Password                  types.String `tfsdk:"password"`
 	PersonalAccessTokenName   types.String `tfsdk:"personal_access_token_name"`
 	PersonalAccessTokenSecret types.String `tfsdk:"personal_access_token_secret"`
+	ConnectedAppClientID      types.String `tfsdk:"connected_app_client_id"`
+	ConnectedAppSecretID      types.String `tfsdk:"connected_app_secret_id"`
+	ConnectedAppSecretValue   types.String `tfsdk:"connected_app_secret_value"`
+	ConnectedAppUsername      types.String `tfsdk:"connected_app_username"`
+	ConnectedAppScopes        types.List   `tfsdk:"connected_app_scopes"`
 	Site                      types.String `tfsdk:"site"`
+	PageSize                  types.Int64  `tfsdk:"page_size"`
+	RequestTimeout            types.String `tfsdk:"request_timeout"`
+	RetryMaxAttempts          types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMinBackoff           types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff           types.String `tfsdk:"retry_max_backoff"`
+	RetryJitter               types.Bool   `tfsdk:"retry_jitter"`
+	RetryPermissionUpdates    types.Bool   `tfsdk:"retry_permission_updates"`
+	CACertificate             types.String `tfsdk:"ca_certificate"`
+	ClientCertificate         types.String `tfsdk:"client_certificate"`
+	ClientKey                 types.String `tfsdk:"client_key"`
+	InsecureSkipVerify        types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL                  types.String `tfsdk:"proxy_url"`
 }
 
 func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -97,7 +198,7 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		resp.Diagnostics.AddAttributeError(
 			path.Root("server_version"),
 			"Unknown Tableau Server version",
-			"Tableau Server Version must be provided in order to establish a connection, currently no default is set",
+			"Tableau Server Version must be known in order to establish a connection, either set a static value or remove it to read the version from the server",
 		)
 	}
 
@@ -127,6 +228,14 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.ConnectedAppClientID.IsUnknown() || config.ConnectedAppSecretID.IsUnknown() || config.ConnectedAppSecretValue.IsUnknown() || config.ConnectedAppUsername.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("connected_app_client_id"),
+			"Unknown Tableau Connected App Credentials",
+			"Tableau Connected App client ID, secret ID, secret value and username must be known in order to establish a connection",
+		)
+	}
+
 	if config.Site.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("site"),
@@ -135,6 +244,14 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.CACertificate.IsUnknown() || config.ClientCertificate.IsUnknown() || config.ClientKey.IsUnknown() || config.InsecureSkipVerify.IsUnknown() || config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_certificate"),
+			"Unknown Tableau Connection Settings",
+			"Tableau CA certificate, client certificate and key, insecure_skip_verify and proxy URL must be known in order to establish a connection",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -145,7 +262,33 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 	password := os.Getenv("TABLEAU_PASSWORD")
 	personalAccessTokenName := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_NAME")
 	personalAccessTokenSecret := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_SECRET")
+	connectedAppClientID := os.Getenv("TABLEAU_CONNECTED_APP_CLIENT_ID")
+	connectedAppSecretID := os.Getenv("TABLEAU_CONNECTED_APP_SECRET_ID")
+	connectedAppSecretValue := os.Getenv("TABLEAU_CONNECTED_APP_SECRET_VALUE")
+	connectedAppUsername := os.Getenv("TABLEAU_CONNECTED_APP_USERNAME")
+	var connectedAppScopes []string
+	if scopes := os.Getenv("TABLEAU_CONNECTED_APP_SCOPES"); scopes != "" {
+		connectedAppScopes = strings.Split(scopes, ",")
+	}
 	site := os.Getenv("TABLEAU_SITE_NAME")
+	pageSize := getEnvInt(&resp.Diagnostics, "page_size", "TABLEAU_PAGE_SIZE", 0)
+	requestTimeout := getEnvDuration(&resp.Diagnostics, "request_timeout", "TABLEAU_REQUEST_TIMEOUT", defaultRequestTimeout)
+	retryPolicy := DefaultRetryPolicy()
+	retryPolicy.MaxAttempts = getEnvInt(&resp.Diagnostics, "retry_max_attempts", "TABLEAU_RETRY_MAX_ATTEMPTS", retryPolicy.MaxAttempts)
+	retryPolicy.MinBackoff = getEnvDuration(&resp.Diagnostics, "retry_min_backoff", "TABLEAU_RETRY_MIN_BACKOFF", retryPolicy.MinBackoff)
+	retryPolicy.MaxBackoff = getEnvDuration(&resp.Diagnostics, "retry_max_backoff", "TABLEAU_RETRY_MAX_BACKOFF", retryPolicy.MaxBackoff)
+	retryPolicy.Jitter = getEnvBool(&resp.Diagnostics, "retry_jitter", "TABLEAU_RETRY_JITTER", retryPolicy.Jitter)
+	retryPolicy.RetryPermissionUpdates = getEnvBool(&resp.Diagnostics, "retry_permission_updates", "TABLEAU_RETRY_PERMISSION_UPDATES", retryPolicy.RetryPermissionUpdates)
+	transportConfig := TransportConfig{
+		CACertificate:      os.Getenv("TABLEAU_CA_CERTIFICATE"),
+		ClientCertificate:  os.Getenv("TABLEAU_CLIENT_CERTIFICATE"),
+		ClientKey:          os.Getenv("TABLEAU_CLIENT_KEY"),
+		InsecureSkipVerify: getEnvBool(&resp.Diagnostics, "insecure_skip_verify", "TABLEAU_INSECURE_SKIP_VERIFY", false),
+		ProxyURL:           os.Getenv("TABLEAU_PROXY_URL"),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if !config.ServerURL.IsNull() {
 		serverURL = config.ServerURL.ValueString()
@@ -171,57 +314,162 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		personalAccessTokenSecret = config.PersonalAccessTokenSecret.ValueString()
 	}
 
+	if !config.ConnectedAppClientID.IsNull() {
+		connectedAppClientID = config.ConnectedAppClientID.ValueString()
+	}
+
+	if !config.ConnectedAppSecretID.IsNull() {
+		connectedAppSecretID = config.ConnectedAppSecretID.ValueString()
+	}
+
+	if !config.ConnectedAppSecretValue.IsNull() {
+		connectedAppSecretValue = config.ConnectedAppSecretValue.ValueString()
+	}
+
+	if !config.ConnectedAppUsername.IsNull() {
+		connectedAppUsername = config.ConnectedAppUsername.ValueString()
+	}
+
+	if !config.ConnectedAppScopes.IsNull() {
+		resp.Diagnostics.Append(config.ConnectedAppScopes.ElementsAs(ctx, &connectedAppScopes, false)...)
+	}
+
 	if !config.Site.IsNull() {
 		site = config.Site.ValueString()
 	}
 
-	if serverURL == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("server_url"),
-			"Missing Tableau Server URL",
-			"Tableau Server URL must be provided in order to establish a connection",
-		)
+	if !config.PageSize.IsNull() {
+		pageSize = int(config.PageSize.ValueInt64())
 	}
 
-	if serverVersion == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("server_version"),
-			"Missing Tableau Server version",
-			"Tableau Server Version must be provided in order to establish a connection, currently no default is set",
-		)
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = parseDuration(&resp.Diagnostics, "request_timeout", config.RequestTimeout.ValueString())
 	}
 
-	if username == "" && personalAccessTokenName == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing Tableau Username",
-			"Tableau Username or Personal Access Token Name must be provided in order to establish a connection",
-		)
+	if !config.RetryMaxAttempts.IsNull() {
+		retryPolicy.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+
+	if !config.RetryMinBackoff.IsNull() {
+		retryPolicy.MinBackoff = parseDuration(&resp.Diagnostics, "retry_min_backoff", config.RetryMinBackoff.ValueString())
+	}
+
+	if !config.RetryMaxBackoff.IsNull() {
+		retryPolicy.MaxBackoff = parseDuration(&resp.Diagnostics, "retry_max_backoff", config.RetryMaxBackoff.ValueString())
+	}
+
+	if !config.RetryJitter.IsNull() {
+		retryPolicy.Jitter = config.RetryJitter.ValueBool()
+	}
+
+	if !config.RetryPermissionUpdates.IsNull() {
+		retryPolicy.RetryPermissionUpdates = config.RetryPermissionUpdates.ValueBool()
+	}
+
+	if !config.CACertificate.IsNull() {
+		transportConfig.CACertificate = config.CACertificate.ValueString()
+	}
+
+	if !config.ClientCertificate.IsNull() {
+		transportConfig.ClientCertificate = config.ClientCertificate.ValueString()
+	}
+
+	if !config.ClientKey.IsNull() {
+		transportConfig.ClientKey = config.ClientKey.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		transportConfig.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !config.ProxyURL.IsNull() {
+		transportConfig.ProxyURL = config.ProxyURL.ValueString()
+	}
+
+	if (transportConfig.ClientCertificate == "") != (transportConfig.ClientKey == "") {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("personal_access_token_name"),
-			"Missing Tableau Personal Access Token Name",
-			"Tableau Username or Personal Access Token Name must be provided in order to establish a connection",
+			path.Root("client_certificate"),
+			"Missing Tableau Client Certificate or Key",
+			"client_certificate and client_key must be provided together in order to use mutual TLS",
 		)
 	}
 
-	if password == "" && personalAccessTokenSecret == "" {
+	if retryPolicy.MaxBackoff < retryPolicy.MinBackoff {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing Tableau Password",
-			"Tableau Password or Personal Access Token Secret must be provided in order to establish a connection",
+			path.Root("retry_max_backoff"),
+			"Invalid Tableau Retry Backoff",
+			"retry_max_backoff must be at least as long as retry_min_backoff",
 		)
+	}
+
+	if serverURL == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("personal_access_token_secret"),
-			"Missing Tableau Personal Access Token Secret",
-			"Tableau Password or Personal Access Token Secret must be provided in order to establish a connection",
+			path.Root("server_url"),
+			"Missing Tableau Server URL",
+			"Tableau Server URL must be provided in order to establish a connection",
 		)
 	}
 
+	if serverVersion != "" {
+		if _, err := parseApiVersion(serverVersion); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("server_version"),
+				"Invalid Tableau Server version",
+				"Tableau Server Version must be a REST API version such as 3.21: "+err.Error(),
+			)
+		}
+	}
+
+	var connectedApp *ConnectedApp
+	if connectedAppClientID != "" {
+		if connectedAppSecretID == "" || connectedAppSecretValue == "" || connectedAppUsername == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("connected_app_client_id"),
+				"Missing Tableau Connected App Credentials",
+				"Tableau Connected App secret ID, secret value and username must all be provided alongside the client ID in order to establish a connection",
+			)
+		}
+		connectedApp = &ConnectedApp{
+			ClientID:    connectedAppClientID,
+			SecretID:    connectedAppSecretID,
+			SecretValue: connectedAppSecretValue,
+			Username:    connectedAppUsername,
+			Scopes:      connectedAppScopes,
+		}
+	} else {
+		if username == "" && personalAccessTokenName == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing Tableau Username",
+				"Tableau Username or Personal Access Token Name must be provided in order to establish a connection",
+			)
+			resp.Diagnostics.AddAttributeError(
+				path.Root("personal_access_token_name"),
+				"Missing Tableau Personal Access Token Name",
+				"Tableau Username or Personal Access Token Name must be provided in order to establish a connection",
+			)
+		}
+
+		if password == "" && personalAccessTokenSecret == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing Tableau Password",
+				"Tableau Password or Personal Access Token Secret must be provided in order to establish a connection",
+			)
+			resp.Diagnostics.AddAttributeError(
+				path.Root("personal_access_token_secret"),
+				"Missing Tableau Personal Access Token Secret",
+				"Tableau Password or Personal Access Token Secret must be provided in order to establish a connection",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	client, err := NewClient(
+		ctx,
 		&serverURL,
 		&username,
 		&password,
@@ -229,6 +477,10 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		&personalAccessTokenSecret,
 		&site,
 		&serverVersion,
+		connectedApp,
+		&retryPolicy,
+		&transportConfig,
+		requestTimeout,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -239,6 +491,10 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 		return
 	}
+	client.PageSize = pageSize
+	registerClient(client)
+
+	tflog.Debug(ctx, "Using Tableau REST API version", map[string]any{"api_version": client.ApiVersion})
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -254,11 +510,14 @@ func (p *tableauProvider) DataSources(_ context.Context) []func() datasource.Dat
 		UsersDataSource,
 		ProjectDataSource,
 		ProjectsDataSource,
+		ProjectTreeDataSource,
 		SiteDataSource,
 		DatasourceDataSource,
 		DatasourcesDataSource,
 		DefaultPermissionsDataSource,
 		ProjectPermissionsDataSource,
+		PermissionTemplateDataSource,
+		EffectivePermissionsDataSource,
 		VirtualConnectionDataSource,
 		VirtualConnectionsDataSource,
 		VirtualConnectionConnectionsDataSource,
@@ -266,6 +525,10 @@ func (p *tableauProvider) DataSources(_ context.Context) []func() datasource.Dat
 		WorkbookConnectionsDataSource,
 		WorkbooksDataSource,
 		WorkbookRevisionsDataSource,
+		SchedulesDataSource,
+		ExtractRefreshTasksDataSource,
+		JobsDataSource,
+		SubscriptionsDataSource,
 	}
 }
 
@@ -281,5 +544,77 @@ func (p *tableauProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewViewPermissionResource,
 		NewVirtualConnectionPermissionResource,
 		NewWorkbookPermissionResource,
+		NewWorkbookResource,
+		NewDatasourceResource,
+		NewVirtualConnectionResource,
+		NewProjectPermissionsResource,
+		NewWorkbookPermissionsResource,
+		NewDatasourcePermissionsResource,
+		NewViewPermissionsResource,
+		NewVirtualConnectionPermissionsResource,
+		NewDefaultPermissionsResource,
+		NewContentOwnershipResource,
+		NewScheduleResource,
+		NewExtractRefreshTaskResource,
+		NewExtractRefreshResource,
+		NewSubscriptionResource,
+	}
+}
+
+// getEnvInt reads a whole number from envVar, returning fallback when it is unset
+func getEnvInt(diags *diag.Diagnostics, attribute, envVar string, fallback int) int {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Tableau Provider Environment Variable",
+			fmt.Sprintf("%s must be a whole number: %s", envVar, err.Error()),
+		)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvBool reads a boolean from envVar, returning fallback when it is unset
+func getEnvBool(diags *diag.Diagnostics, attribute, envVar string, fallback bool) bool {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Tableau Provider Environment Variable",
+			fmt.Sprintf("%s must be true or false: %s", envVar, err.Error()),
+		)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvDuration reads a duration such as 30s from envVar, returning fallback when it is unset
+func getEnvDuration(diags *diag.Diagnostics, attribute, envVar string, fallback time.Duration) time.Duration {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return fallback
+	}
+	return parseDuration(diags, attribute, value)
+}
+
+func parseDuration(diags *diag.Diagnostics, attribute, value string) time.Duration {
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Tableau Provider Duration",
+			fmt.Sprintf("%s must be a positive duration such as 500ms or 30s, got %q", attribute, value),
+		)
+		return 0
 	}
+	return parsed
 }
@@ -0,0 +1,158 @@
+package tableau
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxSinglePublishSize is the largest file Tableau accepts in a single publish
+// request, anything larger has to be uploaded in chunks
+const maxSinglePublishSize = 64 * megabyte
+
+// ContentReference identifies a related object in a publish or update
+// request, e.g. {"project":{"id":"..."}}
+type ContentReference struct {
+	ID string `json:"id"`
+}
+
+type ConnectionCredentials struct {
+	Name     string `json:"name,omitempty"`
+	Password string `json:"password,omitempty"`
+	Embed    string `json:"embed,omitempty"`
+}
+
+type PublishConnection struct {
+	ServerAddress         string                 `json:"serverAddress,omitempty"`
+	ServerPort            string                 `json:"serverPort,omitempty"`
+	ConnectionCredentials *ConnectionCredentials `json:"connectionCredentials,omitempty"`
+}
+
+type PublishConnections struct {
+	Connections []PublishConnection `json:"connection"`
+}
+
+// publishFileType returns the file extension Tableau expects in the publish
+// type query parameter, checking it is one of allowedTypes
+func publishFileType(filePath string, allowedTypes ...string) (string, error) {
+	fileType := strings.ToLower(strings.TrimPrefix(filepath.Ext(filePath), "."))
+	for _, allowedType := range allowedTypes {
+		if fileType == allowedType {
+			return fileType, nil
+		}
+	}
+	return "", fmt.Errorf("%s must be a .%s file", filePath, strings.Join(allowedTypes, ", ."))
+}
+
+// fileSHA256 returns the hex encoded SHA-256 of a file's content, used to
+// detect when a published file has changed
+func fileSHA256(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// newPublishRequest builds a multipart/mixed publish request holding payload
+// as the request_payload part, followed by the file as filePartName when
+// filePath is set
+func newPublishRequest(ctx context.Context, endpoint string, payload any, filePartName, filePath string) (*http.Request, error) {
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	payloadPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`name="request_payload"`},
+		"Content-Type":        {"application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := payloadPart.Write(payloadJson); err != nil {
+		return nil, err
+	}
+
+	if filePath != "" {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		filePart, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Disposition": {fmt.Sprintf(`name="%s"; filename="%s"`, filePartName, escapeQuotes(filepath.Base(filePath)))},
+			"Content-Type":        {"application/octet-stream"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(filePart, file); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// a bytes.Reader body lets the request be replayed on retry
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+	return req, nil
+}
+
+// publishContent posts payload and the file to the publish endpoint, in a
+// single request when the file is small enough, otherwise uploading the file
+// in chunks first and committing the upload session
+func (c *Client) publishContent(ctx context.Context, endpoint string, query url.Values, payload any, filePartName, filePath string) ([]byte, error) {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	if fileInfo.Size() <= maxSinglePublishSize {
+		req, err = newPublishRequest(ctx, endpoint+"?"+query.Encode(), payload, filePartName, filePath)
+	} else {
+		uploadSessionID, uploadErr := c.UploadFile(ctx, filePath)
+		if uploadErr != nil {
+			return nil, uploadErr
+		}
+		query.Set("uploadSessionId", uploadSessionID)
+		req, err = newPublishRequest(ctx, endpoint+"?"+query.Encode(), payload, "", "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(fileTransfer(req))
+}
+
+func escapeQuotes(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
@@ -0,0 +1,118 @@
+package tableau
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// schema and plan handling shared by the resources publishing local files
+
+type publishConnectionModel struct {
+	ServerAddress types.String `tfsdk:"server_address"`
+	ServerPort    types.String `tfsdk:"server_port"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	EmbedPassword types.Bool   `tfsdk:"embed_password"`
+}
+
+func publishConnectionsAttribute(contentType string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: "Credentials for the connections in the " + contentType + ", matched on server address and port, changing these publishes the file again",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"server_address": schema.StringAttribute{
+					Required:    true,
+					Description: "Server address of the connection",
+				},
+				"server_port": schema.StringAttribute{
+					Optional:    true,
+					Description: "Server port of the connection",
+				},
+				"username": schema.StringAttribute{
+					Optional:    true,
+					Description: "Username for the connection",
+				},
+				"password": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password for the connection",
+				},
+				"embed_password": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Embed the credentials in the " + contentType + " so viewers are not prompted for them",
+				},
+			},
+		},
+	}
+}
+
+// modifyPublishPlan sets content_hash from the file at file_path
+func modifyPublishPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filePath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file_path"), &filePath)...)
+	if resp.Diagnostics.HasError() || filePath.IsNull() || filePath.IsUnknown() {
+		return
+	}
+
+	contentHash, err := fileSHA256(filePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Error Reading File",
+			"Could not read "+filePath.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)...)
+}
+
+func publishConnectionsFromModel(connections []publishConnectionModel) *PublishConnections {
+	if len(connections) == 0 {
+		return nil
+	}
+	publishConnections := PublishConnections{}
+	for _, connection := range connections {
+		publishConnection := PublishConnection{
+			ServerAddress: connection.ServerAddress.ValueString(),
+			ServerPort:    connection.ServerPort.ValueString(),
+		}
+		if !connection.Username.IsNull() || !connection.Password.IsNull() {
+			publishConnection.ConnectionCredentials = &ConnectionCredentials{
+				Name:     connection.Username.ValueString(),
+				Password: connection.Password.ValueString(),
+				Embed:    strconv.FormatBool(connection.EmbedPassword.ValueBool()),
+			}
+		}
+		publishConnections.Connections = append(publishConnections.Connections, publishConnection)
+	}
+	return &publishConnections
+}
+
+func publishConnectionsEqual(a, b []publishConnectionModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].ServerAddress.Equal(b[i].ServerAddress) ||
+			!a[i].ServerPort.Equal(b[i].ServerPort) ||
+			!a[i].Username.Equal(b[i].Username) ||
+			!a[i].Password.Equal(b[i].Password) ||
+			!a[i].EmbedPassword.Equal(b[i].EmbedPassword) {
+			return false
+		}
+	}
+	return true
+}
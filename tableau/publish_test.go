@@ -0,0 +1,68 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewPublishRequest(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "Sales \"Q1\".twbx")
+	if err := os.WriteFile(filePath, []byte("workbook content"), 0o600); err != nil {
+		t.Fatalf("unable to write file: %s", err)
+	}
+
+	payload := PublishWorkbookRequest{Workbook: PublishWorkbook{Name: "Sales", ShowTabs: "false", Project: ContentReference{ID: "project-luid"}}}
+	req, err := newPublishRequest(context.Background(), "https://tableau.example.com/workbooks", payload, "tableau_workbook", filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("unexpected content type %q", req.Header.Get("Content-Type"))
+	}
+	reader := multipart.NewReader(req.Body, params["boundary"])
+
+	payloadPart, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("unexpected error reading payload part: %s", err)
+	}
+	if payloadPart.Header.Get("Content-Disposition") != `name="request_payload"` || payloadPart.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected payload part headers %v", payloadPart.Header)
+	}
+	publishedPayload := PublishWorkbookRequest{}
+	if err := json.NewDecoder(payloadPart).Decode(&publishedPayload); err != nil || publishedPayload.Workbook.Project.ID != "project-luid" {
+		t.Errorf("unexpected payload %+v: %v", publishedPayload, err)
+	}
+
+	filePart, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("unexpected error reading file part: %s", err)
+	}
+	if filePart.Header.Get("Content-Disposition") != `name="tableau_workbook"; filename="Sales \"Q1\".twbx"` {
+		t.Errorf("unexpected file part headers %v", filePart.Header)
+	}
+	content, _ := io.ReadAll(filePart)
+	if string(content) != "workbook content" {
+		t.Errorf("unexpected file content %q", content)
+	}
+
+	if req.GetBody == nil {
+		t.Errorf("expected the publish request to be replayable")
+	}
+}
+
+func TestPublishFileType(t *testing.T) {
+	if fileType, err := publishFileType("dashboards/Sales.TWBX", "twb", "twbx"); err != nil || fileType != "twbx" {
+		t.Errorf("unexpected file type %q: %v", fileType, err)
+	}
+	if _, err := publishFileType("dashboards/Sales.hyper", "twb", "twbx"); err == nil {
+		t.Errorf("expected an error for an unsupported file type")
+	}
+}
@@ -0,0 +1,141 @@
+package tableau
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 1 * time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy controls how doRequest retries rate limited and transiently
+// failing requests
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made, including the first
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+	Jitter      bool
+	// RetryPermissionUpdates allows the permission PUT calls, which grant the
+	// same capabilities however many times they are sent, to be retried
+	RetryPermissionUpdates bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+		Jitter:      true,
+	}
+}
+
+type permissionUpdateKey struct{}
+
+// retryablePermissionUpdate marks a permission PUT request as safe to retry
+// when the policy has RetryPermissionUpdates enabled
+func retryablePermissionUpdate(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), permissionUpdateKey{}, true))
+}
+
+type uploadChunkKey struct{}
+
+// retryableUploadChunk marks a file upload PUT as safe to retry, the upload
+// checking the size Tableau reports afterwards to catch a chunk appended twice
+func retryableUploadChunk(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), uploadChunkKey{}, true))
+}
+
+func (p RetryPolicy) isRetryableMethod(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPut:
+		if isUploadChunk, _ := req.Context().Value(uploadChunkKey{}).(bool); isUploadChunk {
+			return true
+		}
+		isPermissionUpdate, _ := req.Context().Value(permissionUpdateKey{}).(bool)
+		return isPermissionUpdate && p.RetryPermissionUpdates
+	}
+	return false
+}
+
+// shouldRetry reports whether another attempt should be made after a response
+// with statusCode, or a transport error when statusCode is 0. Rate limited
+// requests were never processed so are retried whatever the method.
+func (p RetryPolicy) shouldRetry(req *http.Request, statusCode, attempt int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !p.isRetryableMethod(req) {
+		return false
+	}
+	switch statusCode {
+	case 0, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt, preferring the
+// server's Retry-After header when one was sent
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return retryAfter
+		}
+	}
+
+	wait := time.Duration(float64(p.MinBackoff) * math.Pow(2, float64(attempt-1)))
+	if p.MaxBackoff > 0 && (wait > p.MaxBackoff || wait <= 0) {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter && wait > 0 {
+		// equal jitter, always waiting at least half of the computed backoff
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+	return wait
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if retryAt, err := http.ParseTime(value); err == nil {
+		wait := time.Until(retryAt)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// waitForRetry sleeps for the backoff, returning early with the context's
+// error if the request is cancelled in the meantime
+func waitForRetry(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package tableau
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newFlakyServer(t *testing.T, failures int, status int, retryAfter string) (*httptest.Server, *int) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &attempts
+}
+
+func testRetryClient(server *httptest.Server) *Client {
+	return &Client{
+		ApiUrl:     server.URL,
+		HTTPClient: server.Client(),
+		RetryPolicy: RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  5 * time.Millisecond,
+		},
+	}
+}
+
+func TestDoRequestRetriesServerErrorsOnGet(t *testing.T) {
+	server, attempts := newFlakyServer(t, 2, http.StatusServiceUnavailable, "")
+	c := testRetryClient(server)
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+func TestDoRequestGivesUpAfterMaxAttempts(t *testing.T) {
+	server, attempts := newFlakyServer(t, 5, http.StatusBadGateway, "")
+	c := testRetryClient(server)
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := c.doRequest(req); err == nil {
+		t.Fatal("expected an error once attempts were exhausted")
+	}
+	if *attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+func TestDoRequestRetriesRateLimitedPost(t *testing.T) {
+	server, attempts := newFlakyServer(t, 1, http.StatusTooManyRequests, "0")
+	c := testRetryClient(server)
+
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"project":{}}`))
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", *attempts)
+	}
+}
+
+func TestDoRequestOnlyRetriesPermissionPutsWhenEnabled(t *testing.T) {
+	server, attempts := newFlakyServer(t, 1, http.StatusServiceUnavailable, "")
+	c := testRetryClient(server)
+
+	req, _ := http.NewRequest("PUT", server.URL, strings.NewReader(`{}`))
+	if _, err := c.doRequest(retryablePermissionUpdate(req)); err == nil {
+		t.Fatal("expected permission update not to be retried by default")
+	}
+
+	*attempts = 0
+	c.RetryPolicy.RetryPermissionUpdates = true
+	req, _ = http.NewRequest("PUT", server.URL, strings.NewReader(`{}`))
+	if _, err := c.doRequest(retryablePermissionUpdate(req)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", *attempts)
+	}
+}
+
+func TestRetryAfterIsCappedByMaxBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := policy.backoff(1, res); wait != 10*time.Second {
+		t.Errorf("expected Retry-After to be capped at 10s, got %s", wait)
+	}
+	if wait := policy.backoff(3, nil); wait != 4*time.Second {
+		t.Errorf("expected exponential backoff of 4s, got %s", wait)
+	}
+}
+
+func TestDoRequestStopsRetryingWhenCancelled(t *testing.T) {
+	server, attempts := newFlakyServer(t, 5, http.StatusServiceUnavailable, "")
+	c := testRetryClient(server)
+	c.RetryPolicy.MinBackoff = time.Minute
+	c.RetryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+	_, err := c.doRequest(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to interrupt the backoff, got %v", err)
+	}
+	if *attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", *attempts)
+	}
+}
@@ -0,0 +1,221 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+var (
+	scheduleTypes           = []string{"Extract", "Subscription", "Flow"}
+	scheduleFrequencies     = []string{"Hourly", "Daily", "Weekly", "Monthly"}
+	scheduleExecutionOrders = []string{"Parallel", "Serial"}
+	scheduleStates          = []string{"Active", "Suspended"}
+	scheduleWeekDays        = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+	// scheduleIntervalHours are the hours an Hourly or Daily schedule can
+	// repeat every, and scheduleIntervalMinutes the minutes for Hourly
+	scheduleIntervalHours   = []string{"1", "2", "4", "6", "8", "12", "24"}
+	scheduleIntervalMinutes = []string{"15", "30"}
+)
+
+// ScheduleInterval is one of the intervals of a schedule, only one of its
+// fields being set
+type ScheduleInterval struct {
+	Hours    string `json:"hours,omitempty"`
+	Minutes  string `json:"minutes,omitempty"`
+	WeekDay  string `json:"weekDay,omitempty"`
+	MonthDay string `json:"monthDay,omitempty"`
+}
+
+type ScheduleIntervals struct {
+	Intervals []ScheduleInterval `json:"interval"`
+}
+
+type FrequencyDetails struct {
+	Start     string            `json:"start"`
+	End       string            `json:"end,omitempty"`
+	Intervals ScheduleIntervals `json:"intervals"`
+}
+
+type Schedule struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	State          string `json:"state,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Frequency      string `json:"frequency,omitempty"`
+	ExecutionOrder string `json:"executionOrder,omitempty"`
+	// Priority is sent as a number but may come back as a string
+	Priority         json.Number       `json:"priority,omitempty"`
+	NextRunAt        string            `json:"nextRunAt,omitempty"`
+	CreatedAt        string            `json:"createdAt,omitempty"`
+	UpdatedAt        string            `json:"updatedAt,omitempty"`
+	FrequencyDetails *FrequencyDetails `json:"frequencyDetails,omitempty"`
+}
+
+type ScheduleRequest struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+type ScheduleResponse struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+type SchedulesResponse struct {
+	Schedules []Schedule `json:"schedule"`
+}
+
+type ScheduleListResponse struct {
+	SchedulesResponse SchedulesResponse `json:"schedules"`
+	Pagination        PaginationDetails `json:"pagination"`
+}
+
+func (r ScheduleListResponse) pageItems() []Schedule {
+	return r.SchedulesResponse.Schedules
+}
+
+func (r ScheduleListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+// schedules are defined for the whole server rather than a site, so are
+// served outside of the site's API URL
+
+func (c *Client) GetSchedules(ctx context.Context) ([]Schedule, error) {
+	return listAll[Schedule, ScheduleListResponse](ctx, c, fmt.Sprintf("%s/schedules", c.baseUrl), nil)
+}
+
+// GetSchedule looks a schedule up by ID through the schedule listing, which
+// is the only way to read server schedules on every API version
+func (c *Client) GetSchedule(ctx context.Context, scheduleID string) (*Schedule, error) {
+	schedule, err := findFirst[Schedule, ScheduleListResponse](ctx, c, fmt.Sprintf("%s/schedules", c.baseUrl), nil, func(schedule Schedule) bool {
+		return schedule.ID == scheduleID
+	})
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("Did not find schedule ID %s: %w", scheduleID, ErrNotFound)
+	}
+	return schedule, nil
+}
+
+func (c *Client) CreateSchedule(ctx context.Context, schedule Schedule) (*Schedule, error) {
+	return c.sendSchedule(ctx, "POST", fmt.Sprintf("%s/schedules", c.baseUrl), schedule)
+}
+
+func (c *Client) UpdateSchedule(ctx context.Context, scheduleID string, schedule Schedule) (*Schedule, error) {
+	return c.sendSchedule(ctx, "PUT", fmt.Sprintf("%s/schedules/%s", c.baseUrl, scheduleID), schedule)
+}
+
+func (c *Client) sendSchedule(ctx context.Context, method, endpoint string, schedule Schedule) (*Schedule, error) {
+	scheduleRequest := ScheduleRequest{
+		Schedule: schedule,
+	}
+
+	scheduleJson, err := json.Marshal(scheduleRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, strings.NewReader(string(scheduleJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleResponse := ScheduleResponse{}
+	err = json.Unmarshal(body, &scheduleResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &scheduleResponse.Schedule, nil
+}
+
+func (c *Client) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/schedules/%s", c.baseUrl, scheduleID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateScheduleFrequency checks the frequency details make sense for the
+// frequency, as the server only reports a generic error for them:
+//   - Hourly runs between start and end, every interval of hours or minutes
+//   - Daily runs at start, or between start and end every interval of hours,
+//     on every day or only the week days given
+//   - Weekly runs at start on the week days given
+//   - Monthly runs at start on the days of the month given
+func validateScheduleFrequency(frequency string, details FrequencyDetails) []error {
+	var hours, minutes, weekDays, monthDays []string
+	for _, interval := range details.Intervals.Intervals {
+		if interval.Hours != "" {
+			hours = append(hours, interval.Hours)
+		}
+		if interval.Minutes != "" {
+			minutes = append(minutes, interval.Minutes)
+		}
+		if interval.WeekDay != "" {
+			weekDays = append(weekDays, interval.WeekDay)
+		}
+		if interval.MonthDay != "" {
+			monthDays = append(monthDays, interval.MonthDay)
+		}
+	}
+
+	var errs []error
+	notAllowed := func(attribute string, set bool) {
+		if set {
+			errs = append(errs, fmt.Errorf("%s cannot be set for a %s schedule", attribute, frequency))
+		}
+	}
+	switch frequency {
+	case "Hourly":
+		if details.End == "" {
+			errs = append(errs, fmt.Errorf("end_time is required for an Hourly schedule"))
+		}
+		if len(hours)+len(minutes) != 1 {
+			errs = append(errs, fmt.Errorf("exactly one of interval_hours and interval_minutes is required for an Hourly schedule"))
+		}
+		notAllowed("month_days", len(monthDays) > 0)
+	case "Daily":
+		if (details.End != "") != (len(hours) > 0) {
+			errs = append(errs, fmt.Errorf("end_time and interval_hours must be set together for a Daily schedule"))
+		}
+		if slices.Contains(hours, "1") {
+			errs = append(errs, fmt.Errorf("interval_hours must be at least 2 for a Daily schedule, use an Hourly schedule to run every hour"))
+		}
+		notAllowed("interval_minutes", len(minutes) > 0)
+		notAllowed("month_days", len(monthDays) > 0)
+	case "Weekly":
+		if len(weekDays) == 0 {
+			errs = append(errs, fmt.Errorf("week_days is required for a Weekly schedule"))
+		}
+		notAllowed("end_time", details.End != "")
+		notAllowed("interval_hours", len(hours) > 0)
+		notAllowed("interval_minutes", len(minutes) > 0)
+		notAllowed("month_days", len(monthDays) > 0)
+	case "Monthly":
+		if len(monthDays) == 0 {
+			errs = append(errs, fmt.Errorf("month_days is required for a Monthly schedule"))
+		}
+		notAllowed("end_time", details.End != "")
+		notAllowed("interval_hours", len(hours) > 0)
+		notAllowed("interval_minutes", len(minutes) > 0)
+		notAllowed("week_days", len(weekDays) > 0)
+	}
+	return errs
+}
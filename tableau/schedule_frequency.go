@@ -0,0 +1,233 @@
+package tableau
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	scheduleTimePattern     = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:00$`)
+	scheduleMonthDayPattern = regexp.MustCompile(`^([1-9]|[12][0-9]|3[01]|LastDay)$`)
+)
+
+// scheduleFrequencyModel holds the attributes describing when a schedule
+// runs, shared by server schedules and the schedules of Tableau Cloud tasks
+type scheduleFrequencyModel struct {
+	Frequency       types.String `tfsdk:"frequency"`
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	IntervalHours   types.Int64  `tfsdk:"interval_hours"`
+	IntervalMinutes types.Int64  `tfsdk:"interval_minutes"`
+	WeekDays        types.Set    `tfsdk:"week_days"`
+	MonthDays       types.Set    `tfsdk:"month_days"`
+}
+
+func scheduleFrequencyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"frequency": schema.StringAttribute{
+			Required:    true,
+			Description: "How often the schedule runs, one of " + strings.Join(scheduleFrequencies, "/"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(scheduleFrequencies...),
+			},
+		},
+		"start_time": schema.StringAttribute{
+			Required:    true,
+			Description: "Time of day the schedule runs, or starts running for Hourly and Daily schedules with an interval, in the form HH:MM:00",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(scheduleTimePattern, "must be a time of day in the form HH:MM:00"),
+			},
+		},
+		"end_time": schema.StringAttribute{
+			Optional:    true,
+			Description: "Time of day an Hourly schedule, or Daily schedule with interval_hours, stops running, in the form HH:MM:00",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(scheduleTimePattern, "must be a time of day in the form HH:MM:00"),
+			},
+		},
+		"interval_hours": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Hours between runs of an Hourly or Daily schedule, one of " + strings.Join(scheduleIntervalHours, "/"),
+			Validators: []validator.Int64{
+				int64validator.OneOf(scheduleIntervalValues(scheduleIntervalHours)...),
+				int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("interval_minutes")),
+			},
+		},
+		"interval_minutes": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Minutes between runs of an Hourly schedule, one of " + strings.Join(scheduleIntervalMinutes, "/"),
+			Validators: []validator.Int64{
+				int64validator.OneOf(scheduleIntervalValues(scheduleIntervalMinutes)...),
+			},
+		},
+		"week_days": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Days of the week a Weekly schedule runs, or an Hourly or Daily schedule is limited to, any of " + strings.Join(scheduleWeekDays, "/"),
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(scheduleWeekDays...)),
+			},
+		},
+		"month_days": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Days of the month a Monthly schedule runs, 1 to 31 or LastDay",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(scheduleMonthDayPattern, "must be a day of the month from 1 to 31 or LastDay")),
+			},
+		},
+	}
+}
+
+func scheduleIntervalValues(values []string) []int64 {
+	intervals := []int64{}
+	for _, value := range values {
+		interval, _ := strconv.ParseInt(value, 10, 64)
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+// validate checks the frequency details fit the frequency, reporting any
+// problem against the frequency attribute at frequencyPath
+func (m scheduleFrequencyModel) validate(ctx context.Context, frequencyPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.Frequency.IsUnknown() || m.EndTime.IsUnknown() || m.IntervalHours.IsUnknown() ||
+		m.IntervalMinutes.IsUnknown() || m.WeekDays.IsUnknown() || m.MonthDays.IsUnknown() {
+		return diags
+	}
+
+	details, diags := m.frequencyDetails(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	for _, err := range validateScheduleFrequency(m.Frequency.ValueString(), details) {
+		diags.AddAttributeError(frequencyPath, "Invalid Schedule Frequency", err.Error())
+	}
+	return diags
+}
+
+func (m scheduleFrequencyModel) frequencyDetails(ctx context.Context) (FrequencyDetails, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	details := FrequencyDetails{
+		Start: m.StartTime.ValueString(),
+		End:   m.EndTime.ValueString(),
+	}
+	if !m.IntervalHours.IsNull() {
+		details.Intervals.Intervals = append(details.Intervals.Intervals, ScheduleInterval{Hours: strconv.FormatInt(m.IntervalHours.ValueInt64(), 10)})
+	}
+	if !m.IntervalMinutes.IsNull() {
+		details.Intervals.Intervals = append(details.Intervals.Intervals, ScheduleInterval{Minutes: strconv.FormatInt(m.IntervalMinutes.ValueInt64(), 10)})
+	}
+
+	weekDays, monthDays := []string{}, []string{}
+	diags.Append(m.WeekDays.ElementsAs(ctx, &weekDays, false)...)
+	diags.Append(m.MonthDays.ElementsAs(ctx, &monthDays, false)...)
+	slices.SortFunc(weekDays, func(a, b string) int {
+		return slices.Index(scheduleWeekDays, a) - slices.Index(scheduleWeekDays, b)
+	})
+	for _, weekDay := range weekDays {
+		details.Intervals.Intervals = append(details.Intervals.Intervals, ScheduleInterval{WeekDay: weekDay})
+	}
+	for _, monthDay := range monthDays {
+		details.Intervals.Intervals = append(details.Intervals.Intervals, ScheduleInterval{MonthDay: monthDay})
+	}
+	return details, diags
+}
+
+// setFrequency updates the model from a schedule read from the server
+func (m *scheduleFrequencyModel) setFrequency(ctx context.Context, schedule *Schedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Frequency = types.StringValue(schedule.Frequency)
+	details := FrequencyDetails{}
+	if schedule.FrequencyDetails != nil {
+		details = *schedule.FrequencyDetails
+	}
+	m.StartTime = types.StringValue(details.Start)
+	m.EndTime = types.StringNull()
+	if details.End != "" {
+		m.EndTime = types.StringValue(details.End)
+	}
+
+	m.IntervalHours, m.IntervalMinutes = types.Int64Null(), types.Int64Null()
+	var weekDays, monthDays []string
+	for _, interval := range details.Intervals.Intervals {
+		switch {
+		case interval.Hours != "":
+			hours, err := strconv.ParseInt(interval.Hours, 10, 64)
+			if err == nil {
+				m.IntervalHours = types.Int64Value(hours)
+			}
+		case interval.Minutes != "":
+			minutes, err := strconv.ParseInt(interval.Minutes, 10, 64)
+			if err == nil {
+				m.IntervalMinutes = types.Int64Value(minutes)
+			}
+		case interval.WeekDay != "":
+			weekDays = append(weekDays, interval.WeekDay)
+		case interval.MonthDay != "":
+			monthDays = append(monthDays, interval.MonthDay)
+		}
+	}
+	// Hourly and Daily schedules not limited to some days list every day
+	if m.WeekDays.IsNull() && schedule.Frequency != "Weekly" && len(weekDays) == len(scheduleWeekDays) {
+		weekDays = nil
+	}
+
+	var setDiags diag.Diagnostics
+	m.WeekDays, m.MonthDays = types.SetNull(types.StringType), types.SetNull(types.StringType)
+	if len(weekDays) > 0 {
+		m.WeekDays, setDiags = types.SetValueFrom(ctx, types.StringType, weekDays)
+		diags.Append(setDiags...)
+	}
+	if len(monthDays) > 0 {
+		m.MonthDays, setDiags = types.SetValueFrom(ctx, types.StringType, monthDays)
+		diags.Append(setDiags...)
+	}
+	return diags
+}
+
+// schedule returns the schedule of a task or subscription with a schedule of
+// its own, as sent alongside it
+func (m scheduleFrequencyModel) schedule(ctx context.Context) (Schedule, diag.Diagnostics) {
+	details, diags := m.frequencyDetails(ctx)
+	return Schedule{
+		Frequency:        m.Frequency.ValueString(),
+		FrequencyDetails: &details,
+	}, diags
+}
+
+// taskSchedule returns the schedule of a task or subscription, which runs
+// either on a server schedule known by its ID or on a schedule of its own,
+// only the one already in use being updated. An import, knowing neither, goes
+// by whether the schedule has an ID.
+func taskSchedule(ctx context.Context, schedule *Schedule, scheduleID types.String, frequency *scheduleFrequencyModel) (types.String, *scheduleFrequencyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if schedule == nil {
+		return scheduleID, frequency, diags
+	}
+	if !scheduleID.IsNull() || (frequency == nil && schedule.ID != "") {
+		return types.StringValue(schedule.ID), frequency, diags
+	}
+	updated := scheduleFrequencyModel{}
+	if frequency != nil {
+		updated = *frequency
+	}
+	diags.Append(updated.setFrequency(ctx, schedule)...)
+	return scheduleID, &updated, diags
+}
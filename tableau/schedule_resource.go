@@ -0,0 +1,303 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &scheduleResource{}
+	_ resource.ResourceWithConfigure      = &scheduleResource{}
+	_ resource.ResourceWithImportState    = &scheduleResource{}
+	_ resource.ResourceWithValidateConfig = &scheduleResource{}
+)
+
+func NewScheduleResource() resource.Resource {
+	return &scheduleResource{}
+}
+
+type scheduleResource struct {
+	client *Client
+}
+
+type scheduleResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	State          types.String `tfsdk:"state"`
+	Priority       types.Int64  `tfsdk:"priority"`
+	ExecutionOrder types.String `tfsdk:"execution_order"`
+	NextRunAt      types.String `tfsdk:"next_run_at"`
+	scheduleFrequencyModel
+}
+
+func (r *scheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the schedule",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the schedule",
+		},
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "Type of task the schedule runs, one of " + strings.Join(scheduleTypes, "/") + ", changing it replaces the schedule",
+			Validators: []validator.String{
+				stringvalidator.OneOf(scheduleTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"state": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the schedule runs, Active or Suspended, defaults to Active",
+			Default:     stringdefault.StaticString("Active"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(scheduleStates...),
+			},
+		},
+		"priority": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Priority of the schedule's tasks from 1 to 100, lower numbers running first, defaults to 50",
+			Default:     int64default.StaticInt64(50),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 100),
+			},
+		},
+		"execution_order": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the schedule's tasks run Parallel or Serial, defaults to Parallel",
+			Default:     stringdefault.StaticString("Parallel"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(scheduleExecutionOrders...),
+			},
+		},
+		"next_run_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the schedule next runs",
+		},
+	}
+	for name, attribute := range scheduleFrequencyAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage a Tableau Server schedule for extract refreshes, subscriptions or flows. " +
+			"Schedules belong to the server rather than a site and are not available on Tableau Cloud.",
+		Attributes: attributes,
+	}
+}
+
+// ValidateConfig checks the frequency details fit the frequency at plan time
+func (r *scheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(config.validate(ctx, path.Root("frequency"))...)
+}
+
+func (r *scheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, diags := scheduleFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a schedule can only be suspended once it exists
+	schedule.State = ""
+	createdSchedule, err := r.client.CreateSchedule(ctx, schedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating schedule",
+			"Could not create schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if plan.State.ValueString() != "Active" {
+		createdSchedule, err = r.client.UpdateSchedule(ctx, createdSchedule.ID, Schedule{State: plan.State.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating schedule",
+				"Could not set the state of schedule, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(createdSchedule.ID)
+	plan.NextRunAt = types.StringValue(createdSchedule.NextRunAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetSchedule(ctx, state.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Schedule",
+			"Could not read Tableau schedule ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setSchedule(ctx, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, diags := scheduleFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the type of a schedule is fixed when it is created
+	schedule.Type = ""
+	updatedSchedule, err := r.client.UpdateSchedule(ctx, plan.ID.ValueString(), schedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Schedule",
+			"Could not update schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.NextRunAt = types.StringValue(updatedSchedule.NextRunAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *scheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSchedule(ctx, state.ID.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Schedule",
+			"Could not delete schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *scheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *scheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func scheduleFromModel(ctx context.Context, model scheduleResourceModel) (Schedule, diag.Diagnostics) {
+	details, diags := model.frequencyDetails(ctx)
+	return Schedule{
+		Name:             model.Name.ValueString(),
+		Type:             model.Type.ValueString(),
+		State:            model.State.ValueString(),
+		Frequency:        model.Frequency.ValueString(),
+		ExecutionOrder:   model.ExecutionOrder.ValueString(),
+		Priority:         json.Number(strconv.FormatInt(model.Priority.ValueInt64(), 10)),
+		FrequencyDetails: &details,
+	}, diags
+}
+
+// setSchedule updates the model from a schedule read from the server
+func (m *scheduleResourceModel) setSchedule(ctx context.Context, schedule *Schedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priority, err := schedule.Priority.Int64()
+	if err != nil {
+		diags.AddError("Error Reading Tableau Schedule", "Could not read priority "+schedule.Priority.String()+": "+err.Error())
+		return diags
+	}
+
+	m.ID = types.StringValue(schedule.ID)
+	m.Name = types.StringValue(schedule.Name)
+	m.Type = types.StringValue(schedule.Type)
+	m.State = types.StringValue(schedule.State)
+	m.Priority = types.Int64Value(priority)
+	m.ExecutionOrder = types.StringValue(schedule.ExecutionOrder)
+	m.NextRunAt = types.StringValue(schedule.NextRunAt)
+	diags.Append(m.setFrequency(ctx, schedule)...)
+	return diags
+}
@@ -0,0 +1,65 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_schedule" "test" {
+  name = "test_schedule_resource"
+  type = "Extract"
+  frequency = "Weekly"
+  start_time = "06:00:00"
+  week_days = ["Monday", "Thursday"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_schedule.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_schedule.test", "next_run_at"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "state", "Active"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "priority", "50"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "execution_order", "Parallel"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "week_days.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "tableau_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_schedule" "test" {
+  name = "test_schedule_resource_renamed"
+  type = "Extract"
+  state = "Suspended"
+  priority = 20
+  execution_order = "Serial"
+  frequency = "Hourly"
+  start_time = "06:00:00"
+  end_time = "18:00:00"
+  interval_hours = 4
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_schedule.test", "name", "test_schedule_resource_renamed"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "state", "Suspended"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "priority", "20"),
+					resource.TestCheckResourceAttr("tableau_schedule.test", "interval_hours", "4"),
+					resource.TestCheckNoResourceAttr("tableau_schedule.test", "week_days"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package tableau
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateScheduleFrequency(t *testing.T) {
+	hours := func(h string) ScheduleInterval { return ScheduleInterval{Hours: h} }
+	weekDay := func(d string) ScheduleInterval { return ScheduleInterval{WeekDay: d} }
+	monthDay := func(d string) ScheduleInterval { return ScheduleInterval{MonthDay: d} }
+	details := func(end string, intervals ...ScheduleInterval) FrequencyDetails {
+		return FrequencyDetails{Start: "06:00:00", End: end, Intervals: ScheduleIntervals{Intervals: intervals}}
+	}
+
+	cases := []struct {
+		name      string
+		frequency string
+		details   FrequencyDetails
+		errors    int
+	}{
+		{"hourly", "Hourly", details("18:00:00", hours("2")), 0},
+		{"hourly every 15 minutes on weekdays", "Hourly", details("18:00:00", ScheduleInterval{Minutes: "15"}, weekDay("Monday")), 0},
+		{"hourly without end or interval", "Hourly", details(""), 2},
+		{"hourly with hours and minutes", "Hourly", details("18:00:00", hours("2"), ScheduleInterval{Minutes: "30"}), 1},
+		{"daily", "Daily", details(""), 0},
+		{"daily every 4 hours", "Daily", details("18:00:00", hours("4")), 0},
+		{"daily with end but no interval", "Daily", details("18:00:00"), 1},
+		{"daily every hour", "Daily", details("18:00:00", hours("1")), 1},
+		{"weekly", "Weekly", details("", weekDay("Monday"), weekDay("Friday")), 0},
+		{"weekly without days", "Weekly", details(""), 1},
+		{"weekly with end and month days", "Weekly", details("18:00:00", weekDay("Monday"), monthDay("1")), 2},
+		{"monthly", "Monthly", details("", monthDay("1"), monthDay("LastDay")), 0},
+		{"monthly with week days", "Monthly", details("", weekDay("Monday")), 2},
+	}
+	for _, c := range cases {
+		if errs := validateScheduleFrequency(c.frequency, c.details); len(errs) != c.errors {
+			t.Errorf("%s: expected %d errors, got %v", c.name, c.errors, errs)
+		}
+	}
+}
+
+func TestScheduleResponsePriority(t *testing.T) {
+	for _, body := range []string{
+		`{"schedule": {"id": "1", "priority": 50}}`,
+		`{"schedule": {"id": "1", "priority": "50"}}`,
+	} {
+		scheduleResponse := ScheduleResponse{}
+		if err := json.Unmarshal([]byte(body), &scheduleResponse); err != nil {
+			t.Fatalf("unexpected error decoding %s: %s", body, err)
+		}
+		if priority, err := scheduleResponse.Schedule.Priority.Int64(); err != nil || priority != 50 {
+			t.Errorf("expected priority 50 from %s, got %d (%v)", body, priority, err)
+		}
+	}
+}
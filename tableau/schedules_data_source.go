@@ -0,0 +1,167 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &schedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &schedulesDataSource{}
+)
+
+func SchedulesDataSource() datasource.DataSource {
+	return &schedulesDataSource{}
+}
+
+type schedulesDataSource struct {
+	client *Client
+}
+
+type schedulesDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	Type      types.String            `tfsdk:"type"`
+	Schedules []scheduleResourceModel `tfsdk:"schedules"`
+}
+
+func (d *schedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+func (d *schedulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the Tableau Server schedules, which belong to the server rather than a site",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the list of schedules",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list schedules of this type, one of " + strings.Join(scheduleTypes, "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleTypes...),
+				},
+			},
+			"schedules": schema.ListNestedAttribute{
+				Description: "List of schedules and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Schedule ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Schedule name",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of task the schedule runs",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Whether the schedule runs, Active or Suspended",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the schedule's tasks from 1 to 100",
+							Computed:    true,
+						},
+						"execution_order": schema.StringAttribute{
+							Description: "Whether the schedule's tasks run Parallel or Serial",
+							Computed:    true,
+						},
+						"frequency": schema.StringAttribute{
+							Description: "How often the schedule runs",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time of day the schedule runs or starts running",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time of day the schedule stops running",
+							Computed:    true,
+						},
+						"interval_hours": schema.Int64Attribute{
+							Description: "Hours between runs",
+							Computed:    true,
+						},
+						"interval_minutes": schema.Int64Attribute{
+							Description: "Minutes between runs",
+							Computed:    true,
+						},
+						"week_days": schema.SetAttribute{
+							Description: "Days of the week the schedule runs on, null when it runs every day",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"month_days": schema.SetAttribute{
+							Description: "Days of the month the schedule runs on",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"next_run_at": schema.StringAttribute{
+							Description: "When the schedule next runs",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *schedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state schedulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := d.client.GetSchedules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Schedules",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Schedules = []scheduleResourceModel{}
+	for _, schedule := range schedules {
+		if !state.Type.IsNull() && schedule.Type != state.Type.ValueString() {
+			continue
+		}
+		scheduleModel := scheduleResourceModel{}
+		resp.Diagnostics.Append(scheduleModel.setSchedule(ctx, &schedule)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Schedules = append(state.Schedules, scheduleModel)
+	}
+
+	state.ID = types.StringValue("allSchedules")
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *schedulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
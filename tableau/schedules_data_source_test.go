@@ -0,0 +1,39 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSchedulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tableau_schedule" "test" {
+  name = "test_schedules_data_source"
+  type = "Subscription"
+  frequency = "Monthly"
+  start_time = "07:00:00"
+  month_days = ["1", "LastDay"]
+}
+data "tableau_schedules" "test" {
+  type = "Subscription"
+  depends_on = [tableau_schedule.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_schedules.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.tableau_schedules.test", "schedules.*", map[string]string{
+						"name":         "test_schedules_data_source",
+						"type":         "Subscription",
+						"frequency":    "Monthly",
+						"month_days.#": "2",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sessionRefreshMargin is how long before the estimated expiry a session is
+// replaced, so requests in flight do not race the expiry. Short sessions use
+// a tenth of their lifetime instead so they are not replaced on every request.
+const sessionRefreshMargin = 5 * time.Minute
+
+func (c *Client) token() string {
+	c.sessionMu.RLock()
+	defer c.sessionMu.RUnlock()
+	return c.AuthToken
+}
+
+func (c *Client) sessionExpiring() bool {
+	if c.credentials == nil {
+		return false
+	}
+	c.sessionMu.RLock()
+	defer c.sessionMu.RUnlock()
+	return !c.refreshAt.IsZero() && time.Now().After(c.refreshAt)
+}
+
+// signIn starts a new session using the client's credentials, replacing the
+// current auth token
+func (c *Client) signIn(ctx context.Context) error {
+	credentials, err := c.credentials()
+	if err != nil {
+		return err
+	}
+	authRequestJson, err := json.Marshal(SignInRequest{Credentials: credentials})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/auth/signin", c.baseUrl), strings.NewReader(string(authRequestJson)))
+	if err != nil {
+		return err
+	}
+
+	body, err := c.sendRequest(req, "")
+	if err != nil {
+		return err
+	}
+
+	ar := SignInResponse{}
+	err = json.Unmarshal(body, &ar)
+	if err != nil {
+		return err
+	}
+
+	var refreshAt time.Time
+	if expiresIn, ok := parseEstimatedTimeToExpiration(ar.SignInResponseData.EstimatedTimeToExpiration); ok {
+		refreshAt = time.Now().Add(expiresIn - min(sessionRefreshMargin, expiresIn/10))
+	}
+
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	c.ApiUrl = fmt.Sprintf("%s/sites/%s", c.baseUrl, *ar.SignInResponseData.SiteDetails.ID)
+	c.AuthToken = ar.SignInResponseData.Token
+	c.refreshAt = refreshAt
+	return nil
+}
+
+// refreshSession signs in again unless another request has already replaced
+// staleToken while this one was waiting, so concurrent requests failing with
+// the same expired token only sign in once
+func (c *Client) refreshSession(ctx context.Context, staleToken string) error {
+	c.signInMu.Lock()
+	defer c.signInMu.Unlock()
+	if c.token() != staleToken {
+		return nil
+	}
+	return c.signIn(ctx)
+}
+
+// SignOut ends the client's session on the server
+func (c *Client) SignOut(ctx context.Context) error {
+	c.signInMu.Lock()
+	defer c.signInMu.Unlock()
+
+	token := c.token()
+	if token == "" || c.baseUrl == "" {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/auth/signout", c.baseUrl), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.sendRequest(req, token)
+	if err != nil {
+		return err
+	}
+
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	c.AuthToken = ""
+	c.refreshAt = time.Time{}
+	return nil
+}
+
+// parseEstimatedTimeToExpiration reads the hours:minutes:seconds duration
+// returned at sign in, where hours may run well past 24, e.g. 361:27:00
+func parseEstimatedTimeToExpiration(value string) (time.Duration, bool) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	var expiresIn time.Duration
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return 0, false
+		}
+		expiresIn += time.Duration(number) * units[i]
+	}
+	return expiresIn, true
+}
+
+// openClients tracks every signed in client so their sessions can be ended
+// when the provider process shuts down
+var openClients = struct {
+	sync.Mutex
+	clients []*Client
+}{}
+
+func registerClient(c *Client) {
+	openClients.Lock()
+	defer openClients.Unlock()
+	openClients.clients = append(openClients.clients, c)
+}
+
+// SignOutAll ends the session of every client configured by the provider,
+// returning the first error encountered
+func SignOutAll(ctx context.Context) error {
+	openClients.Lock()
+	clients := openClients.clients
+	openClients.clients = nil
+	openClients.Unlock()
+
+	var firstErr error
+	for _, c := range clients {
+		if err := c.SignOut(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
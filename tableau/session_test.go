@@ -0,0 +1,115 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSessionServer stubs sign in, sign out and a users endpoint that rejects
+// any token other than the most recently issued one
+func newSessionServer(t *testing.T, estimatedTimeToExpiration string) (*httptest.Server, *int32, *int32) {
+	var signIns, signOuts int32
+	var mu sync.Mutex
+	currentToken := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/api/3.21/auth/signin":
+			currentToken = fmt.Sprintf("token-%d", atomic.AddInt32(&signIns, 1))
+			fmt.Fprintf(w, `{"credentials":{"site":{"id":"site-luid"},"token":"%s","estimatedTimeToExpiration":"%s"}}`, currentToken, estimatedTimeToExpiration)
+		case "/api/3.21/auth/signout":
+			atomic.AddInt32(&signOuts, 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			if r.Header.Get("X-Tableau-Auth") != currentToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":{"summary":"Signin Error","detail":"Invalid authentication credentials were provided.","code":"401002"}}`))
+				return
+			}
+			w.Write([]byte(`{"user":{"id":"user-luid"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &signIns, &signOuts
+}
+
+func newSessionClient(t *testing.T, server *httptest.Server) *Client {
+	serverURL, serverVersion, site, username, password := server.URL, "3.21", "", "admin", "secret"
+	c, err := NewClient(context.Background(), &serverURL, &username, &password, nil, nil, &site, &serverVersion, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error signing in: %s", err)
+	}
+	return c
+}
+
+func TestDoRequestSignsInAgainAfterUnauthorized(t *testing.T) {
+	server, signIns, _ := newSessionServer(t, "361:27:00")
+	c := newSessionClient(t, server)
+
+	// simulate the server expiring the session
+	c.AuthToken = "expired"
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetUser(context.Background(), "user-luid"); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(signIns); got != 2 {
+		t.Errorf("expected concurrent requests to share a single new sign in, got %d sign ins", got)
+	}
+}
+
+func TestDoRequestRefreshesExpiringSession(t *testing.T) {
+	server, signIns, _ := newSessionServer(t, "0:01:00")
+	c := newSessionClient(t, server)
+	if c.sessionExpiring() {
+		t.Fatalf("expected a new session not to need refreshing yet")
+	}
+	c.refreshAt = time.Now().Add(-time.Second)
+
+	if _, err := c.GetUser(context.Background(), "user-luid"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(signIns); got != 2 {
+		t.Errorf("expected the expiring session to be replaced before the request, got %d sign ins", got)
+	}
+}
+
+func TestSignOutAll(t *testing.T) {
+	server, _, signOuts := newSessionServer(t, "361:27:00")
+	c := newSessionClient(t, server)
+	registerClient(c)
+
+	if err := SignOutAll(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(signOuts); got != 1 {
+		t.Errorf("expected 1 sign out, got %d", got)
+	}
+	if c.token() != "" {
+		t.Errorf("expected the token to be cleared after signing out")
+	}
+}
+
+func TestParseEstimatedTimeToExpiration(t *testing.T) {
+	expiresIn, ok := parseEstimatedTimeToExpiration("361:27:05")
+	if !ok || expiresIn != 361*time.Hour+27*time.Minute+5*time.Second {
+		t.Errorf("unexpected duration %s", expiresIn)
+	}
+	if _, ok := parseEstimatedTimeToExpiration(""); ok {
+		t.Errorf("expected an empty value not to parse")
+	}
+}
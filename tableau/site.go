@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,8 +31,16 @@ type SiteListResponse struct {
 	Pagination    PaginationDetails `json:"pagination"`
 }
 
-func (c *Client) GetSite(siteID string) (*Site, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/sites", c.ApiUrl), nil)
+func (r SiteListResponse) pageItems() []Site {
+	return r.SitesResponse.Sites
+}
+
+func (r SiteListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetSite(ctx context.Context, siteID string) (*Site, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/sites/%s", c.ApiUrl, siteID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,50 +50,16 @@ func (c *Client) GetSite(siteID string) (*Site, error) {
 		return nil, err
 	}
 
-	siteListResponse := SiteListResponse{}
-	err = json.Unmarshal(body, &siteListResponse)
+	siteResponse := SiteResponse{}
+	err = json.Unmarshal(body, &siteResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, _, err := GetPaginationNumbers(siteListResponse.Pagination)
-	if err != nil {
-		return nil, err
-	}
-	for i, site := range siteListResponse.SitesResponse.Sites {
-		if site.ID == siteID {
-			return &siteListResponse.SitesResponse.Sites[i], nil
-		}
-	}
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/sites?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		siteListResponse = SiteListResponse{}
-		err = json.Unmarshal(body, &siteListResponse)
-		if err != nil {
-			return nil, err
-		}
-		// Check page for site match
-		for i, site := range siteListResponse.SitesResponse.Sites {
-			if site.ID == siteID {
-				return &siteListResponse.SitesResponse.Sites[i], nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("Did not find site ID %s", siteID)
+	return &siteResponse.Site, nil
 }
 
-func (c *Client) CreateSite(name, contentURL string) (*Site, error) {
+func (c *Client) CreateSite(ctx context.Context, name, contentURL string) (*Site, error) {
 
 	newSite := Site{
 		Name:       name,
@@ -99,7 +74,7 @@ func (c *Client) CreateSite(name, contentURL string) (*Site, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/sites", c.ApiUrl), strings.NewReader(string(newSiteJson)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/sites", c.ApiUrl), strings.NewReader(string(newSiteJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +93,7 @@ func (c *Client) CreateSite(name, contentURL string) (*Site, error) {
 	return &siteResponse.Site, nil
 }
 
-func (c *Client) UpdateSite(siteID, name, contentURL string) (*Site, error) {
+func (c *Client) UpdateSite(ctx context.Context, siteID, name, contentURL string) (*Site, error) {
 
 	newSite := Site{
 		Name:       name,
@@ -133,7 +108,7 @@ func (c *Client) UpdateSite(siteID, name, contentURL string) (*Site, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/sites/%s", c.ApiUrl, siteID), strings.NewReader(string(newSiteJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/sites/%s", c.ApiUrl, siteID), strings.NewReader(string(newSiteJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +127,9 @@ func (c *Client) UpdateSite(siteID, name, contentURL string) (*Site, error) {
 	return &siteResponse.Site, nil
 }
 
-func (c *Client) DeleteSite(siteID string) error {
+func (c *Client) DeleteSite(ctx context.Context, siteID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/sites/%s", c.ApiUrl, siteID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/sites/%s", c.ApiUrl, siteID), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,105 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const siteAttributeDescription = "Content URL of the site to manage this object in, defaults to the site the provider signs in to"
+
+// siteImportSeparator splits a site content URL from the ID when importing
+// into a site other than the provider's, e.g. finance::<project_id>
+const siteImportSeparator = "::"
+
+func resourceSiteAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Description: siteAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func dataSourceSiteAttribute() dschema.StringAttribute {
+	return dschema.StringAttribute{
+		Optional:    true,
+		Description: "Content URL of the site to read from, defaults to the site the provider signs in to",
+	}
+}
+
+// forSite returns the client to use for objects in site, signing in to the
+// site the first time it is needed
+func (c *Client) forSite(ctx context.Context, site types.String) (*Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if site.IsNull() || site.IsUnknown() || site.ValueString() == c.siteContentUrl {
+		return c, diags
+	}
+
+	siteClient, err := c.siteClient(ctx, site.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("site"),
+			"Error Signing In to Tableau Site",
+			"Could not sign in to Tableau site "+site.ValueString()+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	return siteClient, diags
+}
+
+func (c *Client) siteClient(ctx context.Context, contentUrl string) (*Client, error) {
+	c.sitesMu.Lock()
+	defer c.sitesMu.Unlock()
+
+	if siteClient, ok := c.sites[contentUrl]; ok {
+		return siteClient, nil
+	}
+	if c.siteCredentials == nil {
+		return nil, fmt.Errorf("the provider has no credentials to sign in to other sites with")
+	}
+
+	siteClient := &Client{
+		ApiVersion:     c.ApiVersion,
+		HTTPClient:     c.HTTPClient,
+		PageSize:       c.PageSize,
+		RetryPolicy:    c.RetryPolicy,
+		JobPollPolicy:  c.JobPollPolicy,
+		baseUrl:        c.baseUrl,
+		credentials:    c.siteCredentials(contentUrl),
+		siteContentUrl: contentUrl,
+	}
+	err := siteClient.signIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registerClient(siteClient)
+
+	if c.sites == nil {
+		c.sites = map[string]*Client{}
+	}
+	c.sites[contentUrl] = siteClient
+	return siteClient, nil
+}
+
+// importStatePassthroughIDWithSite imports the ID as is, or the part after
+// siteImportSeparator with the site set from the part before it
+func importStatePassthroughIDWithSite(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, found := strings.Cut(req.ID, siteImportSeparator)
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,94 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestForSiteSignsInOncePerSite(t *testing.T) {
+	var mu sync.Mutex
+	signIns := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signInRequest := SignInRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&signInRequest); err != nil {
+			t.Errorf("unexpected sign in body: %s", err)
+		}
+		contentUrl := signInRequest.Credentials.SiteDetails.ContentUrl
+		mu.Lock()
+		signIns[contentUrl]++
+		mu.Unlock()
+		fmt.Fprintf(w, `{"credentials":{"site":{"id":"%s-luid","contentUrl":"%s"},"token":"%s-token"}}`, contentUrl, contentUrl, contentUrl)
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, serverVersion, site, username, password := server.URL, "3.21", "default", "admin", "secret"
+	c, err := NewClient(context.Background(), &serverURL, &username, &password, nil, nil, &site, &serverVersion, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error signing in: %s", err)
+	}
+
+	defaultClient, diags := c.forSite(context.Background(), types.StringNull())
+	if diags.HasError() || defaultClient != c {
+		t.Errorf("expected no site to use the provider's client")
+	}
+	defaultClient, diags = c.forSite(context.Background(), types.StringValue("default"))
+	if diags.HasError() || defaultClient != c {
+		t.Errorf("expected the provider's site to use the provider's client")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			financeClient, diags := c.forSite(context.Background(), types.StringValue("finance"))
+			if diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+				return
+			}
+			if financeClient.ApiUrl != server.URL+"/api/3.21/sites/finance-luid" {
+				t.Errorf("unexpected API URL %s", financeClient.ApiUrl)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if signIns["default"] != 1 || signIns["finance"] != 1 {
+		t.Errorf("expected a single sign in per site, got %v", signIns)
+	}
+}
+
+// content cannot be moved between sites, so every resource with a site must
+// be replaced when it changes
+func TestResourceSiteRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+	for _, newResource := range (&tableauProvider{}).Resources(ctx) {
+		r := newResource()
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "tableau"}, &metadata)
+		schemaResponse := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+		site, ok := schemaResponse.Schema.Attributes["site"].(schema.StringAttribute)
+		if !ok {
+			continue
+		}
+		requiresReplace := false
+		for _, modifier := range site.PlanModifiers {
+			requiresReplace = requiresReplace || strings.Contains(modifier.Description(ctx), "destroy and recreate")
+		}
+		if !requiresReplace {
+			t.Errorf("expected a change of site to replace %s", metadata.TypeName)
+		}
+	}
+}
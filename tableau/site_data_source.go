@@ -56,7 +56,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	site, err := d.client.GetSite(state.ID.ValueString())
+	site, err := d.client.GetSite(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Site",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,17 +28,18 @@ type siteResource struct {
 }
 
 type siteResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	ContentURL  types.String `tfsdk:"content_url"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	ContentURL  types.String   `tfsdk:"content_url"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
 }
 
 func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_site"
 }
 
-func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *siteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -58,6 +60,14 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -69,12 +79,20 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	site := Site{
 		Name:       plan.Name.ValueString(),
 		ContentURL: plan.ContentURL.ValueString(),
 	}
 
-	createdSite, err := r.client.CreateSite(site.Name, site.ContentURL)
+	createdSite, err := r.client.CreateSite(ctx, site.Name, site.ContentURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating site",
@@ -101,9 +119,24 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	site, err := r.client.GetSite(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	site, err := r.client.GetSite(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Site",
+			"Could not read Tableau site ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -126,12 +159,20 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	site := Site{
 		Name:       plan.Name.ValueString(),
 		ContentURL: plan.ContentURL.ValueString(),
 	}
 
-	_, err := r.client.UpdateSite(plan.ID.ValueString(), site.Name, site.ContentURL)
+	_, err := r.client.UpdateSite(ctx, plan.ID.ValueString(), site.Name, site.ContentURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Site",
@@ -140,7 +181,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	updatedSite, err := r.client.GetSite(plan.ID.ValueString())
+	updatedSite, err := r.client.GetSite(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Site",
@@ -168,7 +209,15 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteSite(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteSite(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Site",
@@ -0,0 +1,150 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	subscriptionContentTypes     = []string{"View", "Workbook"}
+	subscriptionPageOrientations = []string{"PORTRAIT", "LANDSCAPE"}
+	subscriptionPageSizes        = []string{"A3", "A4", "A5", "B4", "B5", "EXECUTIVE", "FOLIO", "LEDGER", "LEGAL", "LETTER", "NOTE", "QUARTO", "TABLOID", "UNSPECIFIED"}
+)
+
+// cloudSubscriptionsApiVersion is the first REST API version able to create
+// and update subscriptions with their own schedule, as Tableau Cloud has no
+// server schedules to subscribe on
+const cloudSubscriptionsApiVersion = "3.20"
+
+// SubscriptionContent is the view or workbook a subscription emails, only
+// SendIfViewEmpty being sent when updating
+type SubscriptionContent struct {
+	ID              string `json:"id,omitempty"`
+	Type            string `json:"type,omitempty"`
+	SendIfViewEmpty bool   `json:"sendIfViewEmpty"`
+}
+
+type Subscription struct {
+	ID              string               `json:"id,omitempty"`
+	Subject         string               `json:"subject"`
+	Message         string               `json:"message"`
+	AttachImage     bool                 `json:"attachImage"`
+	AttachPdf       bool                 `json:"attachPdf"`
+	PageOrientation string               `json:"pageOrientation,omitempty"`
+	PageSizeOption  string               `json:"pageSizeOption,omitempty"`
+	Suspended       bool                 `json:"suspended"`
+	Content         *SubscriptionContent `json:"content,omitempty"`
+	Schedule        *Schedule            `json:"schedule,omitempty"`
+	User            *ContentReference    `json:"user,omitempty"`
+}
+
+// SubscriptionRequest creates or updates a subscription, Schedule holding
+// the subscription's own schedule when it has one rather than a server
+// schedule referenced from the subscription
+type SubscriptionRequest struct {
+	Subscription Subscription `json:"subscription"`
+	Schedule     *Schedule    `json:"schedule,omitempty"`
+}
+
+type SubscriptionResponse struct {
+	Subscription Subscription `json:"subscription"`
+	Schedule     *Schedule    `json:"schedule,omitempty"`
+}
+
+type SubscriptionsResponse struct {
+	Subscriptions []Subscription `json:"subscription"`
+}
+
+type SubscriptionListResponse struct {
+	SubscriptionsResponse SubscriptionsResponse `json:"subscriptions"`
+	Pagination            PaginationDetails     `json:"pagination"`
+}
+
+func (r SubscriptionListResponse) pageItems() []Subscription {
+	return r.SubscriptionsResponse.Subscriptions
+}
+
+func (r SubscriptionListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetSubscriptions(ctx context.Context) ([]Subscription, error) {
+	return listAll[Subscription, SubscriptionListResponse](ctx, c, fmt.Sprintf("%s/subscriptions", c.ApiUrl), nil)
+}
+
+func (c *Client) GetSubscription(ctx context.Context, subscriptionID string) (*Subscription, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/subscriptions/%s", c.ApiUrl, subscriptionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionResponse := SubscriptionResponse{}
+	err = json.Unmarshal(body, &subscriptionResponse)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptionResponse.subscription(), nil
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, subscription SubscriptionRequest) (*Subscription, error) {
+	return c.sendSubscription(ctx, "POST", fmt.Sprintf("%s/subscriptions", c.ApiUrl), subscription)
+}
+
+func (c *Client) UpdateSubscription(ctx context.Context, subscriptionID string, subscription SubscriptionRequest) (*Subscription, error) {
+	return c.sendSubscription(ctx, "PUT", fmt.Sprintf("%s/subscriptions/%s", c.ApiUrl, subscriptionID), subscription)
+}
+
+func (c *Client) sendSubscription(ctx context.Context, method, endpoint string, subscription SubscriptionRequest) (*Subscription, error) {
+	subscriptionJson, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, strings.NewReader(string(subscriptionJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionResponse := SubscriptionResponse{}
+	err = json.Unmarshal(body, &subscriptionResponse)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptionResponse.subscription(), nil
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/subscriptions/%s", c.ApiUrl, subscriptionID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// subscription returns the subscription with its schedule, which is sent
+// alongside rather than within it for a subscription with its own schedule
+func (r SubscriptionResponse) subscription() *Subscription {
+	if r.Subscription.Schedule == nil {
+		r.Subscription.Schedule = r.Schedule
+	}
+	return &r.Subscription
+}
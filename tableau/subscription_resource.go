@@ -0,0 +1,453 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ resource.Resource                   = &subscriptionResource{}
+	_ resource.ResourceWithConfigure      = &subscriptionResource{}
+	_ resource.ResourceWithImportState    = &subscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &subscriptionResource{}
+	_ resource.ResourceWithModifyPlan     = &subscriptionResource{}
+)
+
+func NewSubscriptionResource() resource.Resource {
+	return &subscriptionResource{}
+}
+
+type subscriptionResource struct {
+	client *Client
+}
+
+type subscriptionResourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Subject         types.String            `tfsdk:"subject"`
+	Message         types.String            `tfsdk:"message"`
+	ContentType     types.String            `tfsdk:"content_type"`
+	ContentID       types.String            `tfsdk:"content_id"`
+	UserID          types.String            `tfsdk:"user_id"`
+	ScheduleID      types.String            `tfsdk:"schedule_id"`
+	Schedule        *scheduleFrequencyModel `tfsdk:"schedule"`
+	AttachImage     types.Bool              `tfsdk:"attach_image"`
+	AttachPdf       types.Bool              `tfsdk:"attach_pdf"`
+	PageOrientation types.String            `tfsdk:"page_orientation"`
+	PageSizeOption  types.String            `tfsdk:"page_size_option"`
+	SendIfViewEmpty types.Bool              `tfsdk:"send_if_view_empty"`
+	Suspended       types.Bool              `tfsdk:"suspended"`
+	NextRunAt       types.String            `tfsdk:"next_run_at"`
+	Site            types.String            `tfsdk:"site"`
+}
+
+func (r *subscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a subscription emailing a view or workbook to a user, either on a Tableau Server schedule " +
+			"or, as on Tableau Cloud, with a schedule of its own",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the subscription",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"subject": schema.StringAttribute{
+				Required:    true,
+				Description: "Subject of the subscription email",
+			},
+			"message": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Message included in the subscription email, defaults to empty",
+				Default:     stringdefault.StaticString(""),
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of content emailed, one of " + strings.Join(subscriptionContentTypes, "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(subscriptionContentTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the view or workbook emailed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user the subscription is emailed to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the Tableau Server subscription schedule to email on, exactly one of schedule_id and schedule is required",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("schedule")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+						},
+						"Moving a subscription between a server schedule and a schedule of its own replaces it",
+						"Moving a subscription between a server schedule and a schedule of its own replaces it",
+					),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Schedule of the subscription's own, as Tableau Cloud uses in place of server schedules, exactly one of schedule_id and schedule is required. " +
+					"Needs REST API version " + cloudSubscriptionsApiVersion + " or later.",
+				Attributes: scheduleFrequencyAttributes(),
+			},
+			"attach_image": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Include an image of the content in the email, defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"attach_pdf": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Attach a PDF of the content to the email, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"page_orientation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Orientation of the attached PDF, one of " + strings.Join(subscriptionPageOrientations, "/") + ", defaults to PORTRAIT",
+				Default:     stringdefault.StaticString("PORTRAIT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(subscriptionPageOrientations...),
+				},
+			},
+			"page_size_option": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Page size of the attached PDF, one of " + strings.Join(subscriptionPageSizes, "/") + ", defaults to LETTER",
+				Default:     stringdefault.StaticString("LETTER"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(subscriptionPageSizes...),
+				},
+			},
+			"send_if_view_empty": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Send the email when the view has no data, defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"suspended": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Stop sending the subscription without deleting it, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the subscription is next emailed",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the schedule's frequency details and that the email
+// has something in it at plan time
+func (r *subscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attachImage, attachPdf types.Bool
+	var schedule types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attach_image"), &attachImage)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attach_pdf"), &attachPdf)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// attach_image defaults to true when it is not set
+	if attachImage.IsNull() {
+		attachImage = types.BoolValue(true)
+	}
+	if !attachImage.IsUnknown() && !attachPdf.IsUnknown() && !attachImage.ValueBool() && !attachPdf.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attach_image"),
+			"Invalid Subscription Attachments",
+			"A subscription needs at least one of attach_image and attach_pdf set to true",
+		)
+	}
+
+	if schedule.IsNull() || schedule.IsUnknown() {
+		return
+	}
+	var frequency scheduleFrequencyModel
+	resp.Diagnostics.Append(schedule.As(ctx, &frequency, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(frequency.validate(ctx, path.Root("schedule").AtName("frequency"))...)
+}
+
+// ModifyPlan warns when a subscription with its own schedule is planned
+// against a server too old to create one
+func (r *subscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan subscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ScheduleID.IsNull() {
+		return
+	}
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Subscriptions with their own schedule", cloudSubscriptionsApiVersion)...)
+}
+
+func (r *subscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptionRequest, diags := plan.subscriptionRequest(ctx, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := client.CreateSubscription(ctx, subscriptionRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating subscription",
+			"Could not create subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(subscription.ID)
+	plan.setNextRunAt(subscription)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := client.GetSubscription(ctx, state.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Subscription",
+			"Could not read Tableau subscription ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(subscription.ID)
+	state.Subject = types.StringValue(subscription.Subject)
+	state.Message = types.StringValue(subscription.Message)
+	state.AttachImage = types.BoolValue(subscription.AttachImage)
+	state.AttachPdf = types.BoolValue(subscription.AttachPdf)
+	if subscription.PageOrientation != "" {
+		state.PageOrientation = types.StringValue(subscription.PageOrientation)
+	}
+	if subscription.PageSizeOption != "" {
+		state.PageSizeOption = types.StringValue(subscription.PageSizeOption)
+	}
+	state.Suspended = types.BoolValue(subscription.Suspended)
+	if subscription.Content != nil {
+		state.ContentType = types.StringValue(subscription.Content.Type)
+		state.ContentID = types.StringValue(subscription.Content.ID)
+		state.SendIfViewEmpty = types.BoolValue(subscription.Content.SendIfViewEmpty)
+	}
+	if subscription.User != nil {
+		state.UserID = types.StringValue(subscription.User.ID)
+	}
+	state.ScheduleID, state.Schedule, diags = taskSchedule(ctx, subscription.Schedule, state.ScheduleID, state.Schedule)
+	resp.Diagnostics.Append(diags...)
+	state.setNextRunAt(subscription)
+
+	// an import has none of the defaulted attributes set
+	if state.PageOrientation.IsNull() {
+		state.PageOrientation = types.StringValue("PORTRAIT")
+	}
+	if state.PageSizeOption.IsNull() {
+		state.PageSizeOption = types.StringValue("LETTER")
+	}
+	if state.SendIfViewEmpty.IsNull() {
+		state.SendIfViewEmpty = types.BoolValue(true)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptionRequest, diags := plan.subscriptionRequest(ctx, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := client.UpdateSubscription(ctx, plan.ID.ValueString(), subscriptionRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Subscription",
+			"Could not update subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.setNextRunAt(subscription)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *subscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteSubscription(ctx, state.ID.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Subscription",
+			"Could not delete subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *subscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *subscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
+
+// subscriptionRequest builds the request for the subscription, the content
+// and user it emails only being sent when creating it
+func (m subscriptionResourceModel) subscriptionRequest(ctx context.Context, create bool) (SubscriptionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	subscriptionRequest := SubscriptionRequest{
+		Subscription: Subscription{
+			Subject:         m.Subject.ValueString(),
+			Message:         m.Message.ValueString(),
+			AttachImage:     m.AttachImage.ValueBool(),
+			AttachPdf:       m.AttachPdf.ValueBool(),
+			PageOrientation: m.PageOrientation.ValueString(),
+			PageSizeOption:  m.PageSizeOption.ValueString(),
+			Suspended:       m.Suspended.ValueBool(),
+			Content:         &SubscriptionContent{SendIfViewEmpty: m.SendIfViewEmpty.ValueBool()},
+		},
+	}
+	if create {
+		subscriptionRequest.Subscription.Content.ID = m.ContentID.ValueString()
+		subscriptionRequest.Subscription.Content.Type = m.ContentType.ValueString()
+		subscriptionRequest.Subscription.User = &ContentReference{ID: m.UserID.ValueString()}
+	}
+
+	if !m.ScheduleID.IsNull() {
+		subscriptionRequest.Subscription.Schedule = &Schedule{ID: m.ScheduleID.ValueString()}
+	} else if m.Schedule != nil {
+		schedule, scheduleDiags := m.Schedule.schedule(ctx)
+		diags.Append(scheduleDiags...)
+		subscriptionRequest.Schedule = &schedule
+	}
+	return subscriptionRequest, diags
+}
+
+func (m *subscriptionResourceModel) setNextRunAt(subscription *Subscription) {
+	m.NextRunAt = types.StringNull()
+	if subscription.Schedule != nil && subscription.Schedule.NextRunAt != "" {
+		m.NextRunAt = types.StringValue(subscription.Schedule.NextRunAt)
+	}
+}
@@ -0,0 +1,96 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccSubscriptionContent = `
+resource "tableau_project" "test" {
+  name = "test_subscription_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_workbook" "test" {
+  name = "test_subscription_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/workbook.twb"
+}
+resource "tableau_user" "test" {
+  name = "test_subscription_resource"
+  full_name = "Subscription Resource"
+  email = "test_subscription_resource@test.test"
+  site_role = "Viewer"
+  auth_setting = "SAML"
+}
+`
+
+func TestAccSubscriptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccSubscriptionContent + `
+resource "tableau_subscription" "test" {
+  subject = "test_subscription_resource"
+  content_type = "Workbook"
+  content_id = tableau_workbook.test.id
+  user_id = tableau_user.test.id
+  schedule = {
+    frequency = "Daily"
+    start_time = "07:00:00"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_subscription.test", "id"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "message", ""),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "attach_image", "true"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "attach_pdf", "false"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "send_if_view_empty", "true"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "suspended", "false"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "schedule.frequency", "Daily"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_subscription.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"next_run_at"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccSubscriptionContent + `
+resource "tableau_subscription" "test" {
+  subject = "test_subscription_resource_updated"
+  message = "Latest figures attached"
+  content_type = "Workbook"
+  content_id = tableau_workbook.test.id
+  user_id = tableau_user.test.id
+  attach_pdf = true
+  page_orientation = "LANDSCAPE"
+  page_size_option = "A4"
+  send_if_view_empty = false
+  schedule = {
+    frequency = "Weekly"
+    start_time = "08:00:00"
+    week_days = ["Monday"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_subscription.test", "subject", "test_subscription_resource_updated"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "message", "Latest figures attached"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "attach_pdf", "true"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "page_orientation", "LANDSCAPE"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "page_size_option", "A4"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "send_if_view_empty", "false"),
+					resource.TestCheckResourceAttr("tableau_subscription.test", "schedule.week_days.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSubscriptionRequest(t *testing.T) {
+	ctx := context.Background()
+	model := subscriptionResourceModel{
+		Subject:         types.StringValue("Weekly sales"),
+		Message:         types.StringValue(""),
+		ContentType:     types.StringValue("View"),
+		ContentID:       types.StringValue("v1"),
+		UserID:          types.StringValue("u1"),
+		ScheduleID:      types.StringValue("s1"),
+		AttachImage:     types.BoolValue(false),
+		AttachPdf:       types.BoolValue(true),
+		PageOrientation: types.StringValue("LANDSCAPE"),
+		PageSizeOption:  types.StringValue("A4"),
+		SendIfViewEmpty: types.BoolValue(false),
+		Suspended:       types.BoolValue(false),
+	}
+
+	cases := []struct {
+		name     string
+		create   bool
+		expected string
+	}{
+		{"create", true, `{"subscription":{"subject":"Weekly sales","message":"","attachImage":false,"attachPdf":true,` +
+			`"pageOrientation":"LANDSCAPE","pageSizeOption":"A4","suspended":false,` +
+			`"content":{"id":"v1","type":"View","sendIfViewEmpty":false},"schedule":{"id":"s1"},"user":{"id":"u1"}}}`},
+		{"update", false, `{"subscription":{"subject":"Weekly sales","message":"","attachImage":false,"attachPdf":true,` +
+			`"pageOrientation":"LANDSCAPE","pageSizeOption":"A4","suspended":false,` +
+			`"content":{"sendIfViewEmpty":false},"schedule":{"id":"s1"}}}`},
+	}
+	for _, c := range cases {
+		subscriptionRequest, diags := model.subscriptionRequest(ctx, c.create)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics %v", c.name, diags)
+		}
+		requestJson, err := json.Marshal(subscriptionRequest)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if !jsonSemanticallyEqual(string(requestJson), c.expected) {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, requestJson)
+		}
+	}
+}
+
+func TestSubscriptionRequestOwnSchedule(t *testing.T) {
+	ctx := context.Background()
+	model := subscriptionResourceModel{
+		Subject:    types.StringValue("Daily sales"),
+		ScheduleID: types.StringNull(),
+		Schedule: &scheduleFrequencyModel{
+			Frequency: types.StringValue("Daily"),
+			StartTime: types.StringValue("07:00:00"),
+			WeekDays:  types.SetNull(types.StringType),
+			MonthDays: types.SetNull(types.StringType),
+		},
+	}
+
+	subscriptionRequest, diags := model.subscriptionRequest(ctx, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if subscriptionRequest.Subscription.Schedule != nil {
+		t.Errorf("expected no server schedule, got %+v", subscriptionRequest.Subscription.Schedule)
+	}
+	if subscriptionRequest.Schedule == nil || subscriptionRequest.Schedule.Frequency != "Daily" || subscriptionRequest.Schedule.FrequencyDetails.Start != "07:00:00" {
+		t.Errorf("expected the subscription's own Daily schedule, got %+v", subscriptionRequest.Schedule)
+	}
+}
+
+func TestSubscriptionResponseSchedule(t *testing.T) {
+	for _, body := range []string{
+		`{"subscription": {"id": "1", "schedule": {"frequency": "Daily", "nextRunAt": "2024-01-01T07:00:00Z"}}}`,
+		`{"subscription": {"id": "1"}, "schedule": {"frequency": "Daily", "nextRunAt": "2024-01-01T07:00:00Z"}}`,
+	} {
+		subscriptionResponse := SubscriptionResponse{}
+		if err := json.Unmarshal([]byte(body), &subscriptionResponse); err != nil {
+			t.Fatalf("unexpected error decoding %s: %s", body, err)
+		}
+		subscription := subscriptionResponse.subscription()
+		if subscription.Schedule == nil || subscription.Schedule.Frequency != "Daily" {
+			t.Errorf("expected the Daily schedule from %s, got %+v", body, subscription.Schedule)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &subscriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &subscriptionsDataSource{}
+)
+
+func SubscriptionsDataSource() datasource.DataSource {
+	return &subscriptionsDataSource{}
+}
+
+type subscriptionsDataSource struct {
+	client *Client
+}
+
+type subscriptionsNestedDataModel struct {
+	ID              types.String `tfsdk:"id"`
+	Subject         types.String `tfsdk:"subject"`
+	Message         types.String `tfsdk:"message"`
+	ContentType     types.String `tfsdk:"content_type"`
+	ContentID       types.String `tfsdk:"content_id"`
+	UserID          types.String `tfsdk:"user_id"`
+	ScheduleID      types.String `tfsdk:"schedule_id"`
+	AttachImage     types.Bool   `tfsdk:"attach_image"`
+	AttachPdf       types.Bool   `tfsdk:"attach_pdf"`
+	PageOrientation types.String `tfsdk:"page_orientation"`
+	PageSizeOption  types.String `tfsdk:"page_size_option"`
+	SendIfViewEmpty types.Bool   `tfsdk:"send_if_view_empty"`
+	Suspended       types.Bool   `tfsdk:"suspended"`
+	NextRunAt       types.String `tfsdk:"next_run_at"`
+	scheduleFrequencyModel
+}
+
+type subscriptionsDataSourceModel struct {
+	ID            types.String                   `tfsdk:"id"`
+	UserID        types.String                   `tfsdk:"user_id"`
+	Subscriptions []subscriptionsNestedDataModel `tfsdk:"subscriptions"`
+	Site          types.String                   `tfsdk:"site"`
+}
+
+func (d *subscriptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+func (d *subscriptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the subscriptions of a site emailing views and workbooks to users",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the list of subscriptions",
+			},
+			"site": dataSourceSiteAttribute(),
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list subscriptions emailed to this user",
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Description: "List of subscriptions and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Subscription ID",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "Subject of the subscription email",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Message included in the subscription email",
+							Computed:    true,
+						},
+						"content_type": schema.StringAttribute{
+							Description: "Type of content emailed, View or Workbook",
+							Computed:    true,
+						},
+						"content_id": schema.StringAttribute{
+							Description: "ID of the view or workbook emailed",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "ID of the user the subscription is emailed to",
+							Computed:    true,
+						},
+						"schedule_id": schema.StringAttribute{
+							Description: "ID of the Tableau Server schedule the subscription is emailed on, null when it has a schedule of its own",
+							Computed:    true,
+						},
+						"attach_image": schema.BoolAttribute{
+							Description: "Whether an image of the content is included in the email",
+							Computed:    true,
+						},
+						"attach_pdf": schema.BoolAttribute{
+							Description: "Whether a PDF of the content is attached to the email",
+							Computed:    true,
+						},
+						"page_orientation": schema.StringAttribute{
+							Description: "Orientation of the attached PDF",
+							Computed:    true,
+						},
+						"page_size_option": schema.StringAttribute{
+							Description: "Page size of the attached PDF",
+							Computed:    true,
+						},
+						"send_if_view_empty": schema.BoolAttribute{
+							Description: "Whether the email is sent when the view has no data",
+							Computed:    true,
+						},
+						"suspended": schema.BoolAttribute{
+							Description: "Whether sending the subscription is stopped",
+							Computed:    true,
+						},
+						"next_run_at": schema.StringAttribute{
+							Description: "When the subscription is next emailed",
+							Computed:    true,
+						},
+						"frequency": schema.StringAttribute{
+							Description: "How often the subscription is emailed",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time of day the subscription is emailed or starts being emailed",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time of day the subscription stops being emailed",
+							Computed:    true,
+						},
+						"interval_hours": schema.Int64Attribute{
+							Description: "Hours between emails",
+							Computed:    true,
+						},
+						"interval_minutes": schema.Int64Attribute{
+							Description: "Minutes between emails",
+							Computed:    true,
+						},
+						"week_days": schema.SetAttribute{
+							Description: "Days of the week the subscription is emailed on, null when it is emailed every day",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"month_days": schema.SetAttribute{
+							Description: "Days of the month the subscription is emailed on",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *subscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state subscriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptions, err := client.GetSubscriptions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Subscriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Subscriptions = []subscriptionsNestedDataModel{}
+	for _, subscription := range subscriptions {
+		subscriptionModel := subscriptionsNestedDataModel{
+			ID:              types.StringValue(subscription.ID),
+			Subject:         types.StringValue(subscription.Subject),
+			Message:         types.StringValue(subscription.Message),
+			ContentType:     types.StringNull(),
+			ContentID:       types.StringNull(),
+			UserID:          types.StringNull(),
+			ScheduleID:      types.StringNull(),
+			AttachImage:     types.BoolValue(subscription.AttachImage),
+			AttachPdf:       types.BoolValue(subscription.AttachPdf),
+			PageOrientation: types.StringValue(subscription.PageOrientation),
+			PageSizeOption:  types.StringValue(subscription.PageSizeOption),
+			SendIfViewEmpty: types.BoolNull(),
+			Suspended:       types.BoolValue(subscription.Suspended),
+			NextRunAt:       types.StringNull(),
+		}
+		if subscription.User != nil {
+			subscriptionModel.UserID = types.StringValue(subscription.User.ID)
+		}
+		if !state.UserID.IsNull() && !subscriptionModel.UserID.Equal(state.UserID) {
+			continue
+		}
+		if subscription.Content != nil {
+			subscriptionModel.ContentType = types.StringValue(subscription.Content.Type)
+			subscriptionModel.ContentID = types.StringValue(subscription.Content.ID)
+			subscriptionModel.SendIfViewEmpty = types.BoolValue(subscription.Content.SendIfViewEmpty)
+		}
+		schedule := &Schedule{}
+		if subscription.Schedule != nil {
+			schedule = subscription.Schedule
+		}
+		// subscriptions with their own schedule have no schedule ID
+		if schedule.ID != "" {
+			subscriptionModel.ScheduleID = types.StringValue(schedule.ID)
+		}
+		if schedule.NextRunAt != "" {
+			subscriptionModel.NextRunAt = types.StringValue(schedule.NextRunAt)
+		}
+		resp.Diagnostics.Append(subscriptionModel.setFrequency(ctx, schedule)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Subscriptions = append(state.Subscriptions, subscriptionModel)
+	}
+
+	state.ID = types.StringValue("allSubscriptions")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *subscriptionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
@@ -0,0 +1,57 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSubscriptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_subscriptions_data_source"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_workbook" "test" {
+  name = "test_subscriptions_data_source"
+  project_id = tableau_project.test.id
+  file_path = "testdata/workbook.twb"
+}
+resource "tableau_user" "test" {
+  name = "test_subscriptions_data_source"
+  full_name = "Subscriptions Data Source"
+  email = "test_subscriptions_data_source@test.test"
+  site_role = "Viewer"
+  auth_setting = "SAML"
+}
+resource "tableau_subscription" "test" {
+  subject = "test_subscriptions_data_source"
+  content_type = "Workbook"
+  content_id = tableau_workbook.test.id
+  user_id = tableau_user.test.id
+  schedule = {
+    frequency = "Monthly"
+    start_time = "07:00:00"
+    month_days = ["1"]
+  }
+}
+data "tableau_subscriptions" "test" {
+  user_id = tableau_user.test.id
+  depends_on = [tableau_subscription.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_subscriptions.test", "id"),
+					resource.TestCheckResourceAttr("data.tableau_subscriptions.test", "subscriptions.#", "1"),
+					resource.TestCheckResourceAttr("data.tableau_subscriptions.test", "subscriptions.0.subject", "test_subscriptions_data_source"),
+					resource.TestCheckResourceAttr("data.tableau_subscriptions.test", "subscriptions.0.content_type", "Workbook"),
+					resource.TestCheckResourceAttr("data.tableau_subscriptions.test", "subscriptions.0.frequency", "Monthly"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package tableau
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// TransportConfig customises how the client connects to Tableau, for servers
+// using an internal CA, requiring client certificates or reached via a proxy.
+// Certificates and keys may be given as PEM or as the path to a PEM file.
+type TransportConfig struct {
+	CACertificate      string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+	// ProxyURL overrides the HTTP_PROXY, HTTPS_PROXY and NO_PROXY env vars
+	ProxyURL string
+}
+
+// readPEM returns value when it already holds PEM, otherwise reading it as a
+// path to a file
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// newTransport builds the client's transport, the client certificate also
+// being presented to an HTTPS proxy
+func (t TransportConfig) newTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CACertificate != "" {
+		caCertificate, err := readPEM(t.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("no certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if t.ClientCertificate != "" || t.ClientKey != "" {
+		if t.ClientCertificate == "" || t.ClientKey == "" {
+			return nil, fmt.Errorf("client certificate and client key must be set together")
+		}
+		clientCertificate, err := readPEM(t.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+		clientKey, err := readPEM(t.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+		certificate, err := tls.X509KeyPair(clientCertificate, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if t.ProxyURL != "" {
+		proxyURL, err := url.Parse(t.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("proxy URL %q must include a scheme and host, e.g. http://proxy.example.com:8080", t.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,146 @@
+package tableau
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const signInResponse = `{"credentials":{"site":{"id":"site-luid"},"token":"token"}}`
+
+func newTransportTestClient(t *testing.T, serverURL string, transportConfig *TransportConfig) (*Client, error) {
+	serverVersion, site, username, password := "3.21", "", "admin", "secret"
+	return NewClient(context.Background(), &serverURL, &username, &password, nil, nil, &site, &serverVersion, nil, &RetryPolicy{MaxAttempts: 1}, transportConfig, 0)
+}
+
+func certificatePEM(certificate *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}))
+}
+
+// newClientCertificate returns a self signed client certificate and key as PEM
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+	return certificate, certificatePEM(certificate), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestTransportCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(signInResponse))
+	}))
+	t.Cleanup(server.Close)
+
+	if _, err := newTransportTestClient(t, server.URL, nil); err == nil {
+		t.Errorf("expected the server's certificate to be untrusted by default")
+	}
+
+	if _, err := newTransportTestClient(t, server.URL, &TransportConfig{CACertificate: certificatePEM(server.Certificate())}); err != nil {
+		t.Errorf("unexpected error trusting the CA as PEM: %s", err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(certificatePEM(server.Certificate())), 0o600); err != nil {
+		t.Fatalf("unable to write CA file: %s", err)
+	}
+	if _, err := newTransportTestClient(t, server.URL, &TransportConfig{CACertificate: caFile}); err != nil {
+		t.Errorf("unexpected error trusting the CA from a file: %s", err)
+	}
+
+	if _, err := newTransportTestClient(t, server.URL, &TransportConfig{InsecureSkipVerify: true}); err != nil {
+		t.Errorf("unexpected error skipping verification: %s", err)
+	}
+}
+
+func TestTransportClientCertificate(t *testing.T) {
+	clientCertificate, certificate, key := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCertificate)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(signInResponse))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caCertificate := certificatePEM(server.Certificate())
+	if _, err := newTransportTestClient(t, server.URL, &TransportConfig{CACertificate: caCertificate}); err == nil {
+		t.Errorf("expected the server to reject a client without a certificate")
+	}
+
+	_, err := newTransportTestClient(t, server.URL, &TransportConfig{
+		CACertificate:     caCertificate,
+		ClientCertificate: certificate,
+		ClientKey:         key,
+	})
+	if err != nil {
+		t.Errorf("unexpected error presenting a client certificate: %s", err)
+	}
+
+	if _, err := (TransportConfig{ClientCertificate: certificate}).newTransport(); err == nil {
+		t.Errorf("expected an error for a client certificate without a key")
+	}
+}
+
+func TestTransportProxyURL(t *testing.T) {
+	proxied := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host == "tableau.example.com" {
+			proxied++
+		}
+		w.Write([]byte(signInResponse))
+	}))
+	t.Cleanup(proxy.Close)
+
+	c, err := newTransportTestClient(t, "http://tableau.example.com", &TransportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if proxied != 1 {
+		t.Errorf("expected the sign in to be sent via the proxy, got %d proxied requests", proxied)
+	}
+	if c.ApiUrl != "http://tableau.example.com/api/3.21/sites/site-luid" {
+		t.Errorf("unexpected API URL %s", c.ApiUrl)
+	}
+
+	if _, err := (TransportConfig{ProxyURL: "proxy.example.com"}).newTransport(); err == nil {
+		t.Errorf("expected an error for a proxy URL without a scheme")
+	}
+}
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -33,55 +34,20 @@ type UserListResponse struct {
 	Pagination    PaginationDetails `json:"pagination"`
 }
 
-func (c *Client) GetUsers() ([]User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	userListResponse := UserListResponse{}
-	err = json.Unmarshal(body, &userListResponse)
-	if err != nil {
-		return nil, err
-	}
+func (r UserListResponse) pageItems() []User {
+	return r.UsersResponse.Users
+}
 
-	// TODO: Generalise pagination handling and use elsewhere
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(userListResponse.Pagination)
-	if err != nil {
-		return nil, err
-	}
+func (r UserListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
 
-	allUsers := make([]User, 0, totalAvailable)
-	allUsers = append(allUsers, userListResponse.UsersResponse.Users...)
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/users?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		userListResponse = UserListResponse{}
-		err = json.Unmarshal(body, &userListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allUsers = append(allUsers, userListResponse.UsersResponse.Users...)
-	}
-
-	return allUsers, nil
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	return listAll[User, UserListResponse](ctx, c, fmt.Sprintf("%s/users", c.ApiUrl), nil)
 }
 
-func (c *Client) GetUser(userID string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s/", c.ApiUrl, userID), nil)
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/%s/", c.ApiUrl, userID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +66,7 @@ func (c *Client) GetUser(userID string) (*User, error) {
 	return &userResponse.User, nil
 }
 
-func (c *Client) CreateUser(email, name, fullName, siteRole, authSetting string) (*User, error) {
+func (c *Client) CreateUser(ctx context.Context, email, name, fullName, siteRole, authSetting string) (*User, error) {
 
 	newUser := User{
 		Email:       email,
@@ -118,7 +84,7 @@ func (c *Client) CreateUser(email, name, fullName, siteRole, authSetting string)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users", c.ApiUrl), strings.NewReader(string(newUserJson)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users", c.ApiUrl), strings.NewReader(string(newUserJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +103,7 @@ func (c *Client) CreateUser(email, name, fullName, siteRole, authSetting string)
 	return &userResponse.User, nil
 }
 
-func (c *Client) UpdateUser(userID, email, name, fullName, siteRole, authSetting string) (*User, error) {
+func (c *Client) UpdateUser(ctx context.Context, userID, email, name, fullName, siteRole, authSetting string) (*User, error) {
 
 	newUser := User{
 		Email:       email,
@@ -155,7 +121,7 @@ func (c *Client) UpdateUser(userID, email, name, fullName, siteRole, authSetting
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), strings.NewReader(string(newUserJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), strings.NewReader(string(newUserJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -174,9 +140,9 @@ func (c *Client) UpdateUser(userID, email, name, fullName, siteRole, authSetting
 	return &userResponse.User, nil
 }
 
-func (c *Client) DeleteUser(userID string) error {
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), nil)
 	if err != nil {
 		return err
 	}
@@ -28,6 +28,7 @@ type userDataSourceModel struct {
 	FullName    types.String `tfsdk:"full_name"`
 	SiteRole    types.String `tfsdk:"site_role"`
 	AuthSetting types.String `tfsdk:"auth_setting"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,6 +43,7 @@ func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Required:    true,
 				Description: "ID of the user",
 			},
+			"site": dataSourceSiteAttribute(),
 			"name": schema.StringAttribute{
 				Computed:    true,
 				Description: "Name for the user",
@@ -71,7 +73,13 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	user, err := d.client.GetUser(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.GetUser(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau User",
@@ -87,7 +95,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.SiteRole = types.StringValue(user.SiteRole)
 	state.AuthSetting = types.StringValue(user.AuthSetting)
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,6 +35,7 @@ type userResourceModel struct {
 	SiteRole    types.String `tfsdk:"site_role"`
 	AuthSetting types.String `tfsdk:"auth_setting"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	Site        types.String `tfsdk:"site"`
 }
 
 func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +51,7 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"email": schema.StringAttribute{
 				Required:    true,
 				Description: "User email",
@@ -108,6 +109,12 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	user := User{
 		Email:       plan.Email.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -116,7 +123,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		AuthSetting: plan.AuthSetting.ValueString(),
 	}
 
-	createdUser, err := r.client.CreateUser(user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	createdUser, err := client.CreateUser(ctx, user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating user",
@@ -124,7 +131,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
-	_, err = r.client.UpdateUser(createdUser.ID, user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	_, err = client.UpdateUser(ctx, createdUser.ID, user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating user during create",
@@ -151,9 +158,22 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	user, err := r.client.GetUser(state.ID.ValueString())
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.GetUser(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau User",
+			"Could not read Tableau user ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -179,6 +199,12 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	user := User{
 		Email:       plan.Email.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -187,7 +213,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		AuthSetting: plan.AuthSetting.ValueString(),
 	}
 
-	_, err := r.client.UpdateUser(plan.ID.ValueString(), user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	_, err := client.UpdateUser(ctx, plan.ID.ValueString(), user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau User",
@@ -196,7 +222,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	updatedUser, err := r.client.GetUser(plan.ID.ValueString())
+	updatedUser, err := client.GetUser(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau User",
@@ -227,7 +253,13 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteUser(state.ID.ValueString())
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteUser(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau User",
@@ -246,5 +278,5 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
@@ -31,6 +31,7 @@ type usersNestedDataModel struct {
 type usersDataSourceModel struct {
 	ID    types.String           `tfsdk:"id"`
 	Users []usersNestedDataModel `tfsdk:"users"`
+	Site  types.String           `tfsdk:"site"`
 }
 
 func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +46,7 @@ func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "ID of the users",
 			},
+			"site": dataSourceSiteAttribute(),
 			"users": schema.ListNestedAttribute{
 				Description: "List of users and their attributes",
 				Computed:    true,
@@ -78,7 +80,13 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	users, err := d.client.GetUsers()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := client.GetUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Users",
@@ -99,7 +107,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	state.ID = types.StringValue("allUsers")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
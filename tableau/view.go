@@ -0,0 +1,46 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type View struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ContentURL string `json:"contentUrl,omitempty"`
+	Workbook   struct {
+		ID string `json:"id,omitempty"`
+	} `json:"workbook,omitempty"`
+	Owner struct {
+		ID string `json:"id,omitempty"`
+	} `json:"owner,omitempty"`
+	Project struct {
+		ID string `json:"id,omitempty"`
+	} `json:"project,omitempty"`
+}
+
+type ViewResponse struct {
+	View View `json:"view"`
+}
+
+func (c *Client) GetView(ctx context.Context, viewID string) (*View, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/views/%s", c.ApiUrl, viewID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	viewResponse := ViewResponse{}
+	err = json.Unmarshal(body, &viewResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &viewResponse.View, nil
+}
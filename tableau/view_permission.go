@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,8 +28,8 @@ type ViewPermissionsResponse struct {
 	ViewPermissions ViewPermissions `json:"permissions"`
 }
 
-func (c *Client) GetViewPermission(viewID, entityID, entityType, capabilityName, capabilityMode string) (*ViewPermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/views/%s/permissions", c.ApiUrl, viewID), nil)
+func (c *Client) GetViewPermission(ctx context.Context, viewID, entityID, entityType, capabilityName, capabilityMode string) (*ViewPermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/views/%s/permissions", c.ApiUrl, viewID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +74,10 @@ func (c *Client) GetViewPermission(viewID, entityID, entityType, capabilityName,
 			}
 		}
 	}
-	return nil, nil
+	return nil, fmt.Errorf("Did not find %s %s permission for %s ID %s on view ID %s: %w", capabilityMode, capabilityName, entityType, entityID, viewID, ErrNotFound)
 }
 
-func (c *Client) CreateViewPermissions(viewID string, viewPermissions ViewPermissions) (*ViewPermissions, error) {
+func (c *Client) CreateViewPermissions(ctx context.Context, viewID string, viewPermissions ViewPermissions) (*ViewPermissions, error) {
 
 	viewPermissionsRequest := ViewPermissionsRequest{
 		ViewPermissions: viewPermissions,
@@ -87,12 +88,12 @@ func (c *Client) CreateViewPermissions(viewID string, viewPermissions ViewPermis
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/views/%s/permissions", c.ApiUrl, viewID), strings.NewReader(string(newViewPermissionsJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/views/%s/permissions", c.ApiUrl, viewID), strings.NewReader(string(newViewPermissionsJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doRequest(retryablePermissionUpdate(req))
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (c *Client) CreateViewPermissions(viewID string, viewPermissions ViewPermis
 	return &viewPermissionsResponse.ViewPermissions, nil
 }
 
-func (c *Client) DeleteViewPermission(userID, groupID *string, viewID, capabilityName, capabilityMode string) error {
+func (c *Client) DeleteViewPermission(ctx context.Context, userID, groupID *string, viewID, capabilityName, capabilityMode string) error {
 	var entityID string
 	entityType := "users"
 	if userID != nil {
@@ -116,7 +117,7 @@ func (c *Client) DeleteViewPermission(userID, groupID *string, viewID, capabilit
 		entityID = *groupID
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/views/%s/permissions/%s/%s/%s/%s", c.ApiUrl, viewID, entityType, entityID, capabilityName, capabilityMode), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/views/%s/permissions/%s/%s/%s/%s", c.ApiUrl, viewID, entityType, entityID, capabilityName, capabilityMode), nil)
 
 	if err != nil {
 		return err
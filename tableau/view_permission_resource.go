@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,6 +35,7 @@ type viewPermissionResourceModel struct {
 	GroupID        types.String `tfsdk:"group_id"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
+	Site           types.String `tfsdk:"site"`
 }
 
 func (r *viewPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +51,7 @@ func (r *viewPermissionResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"view_id": schema.StringAttribute{
 				Required:    true,
 				Description: "View ID",
@@ -121,6 +122,12 @@ func (r *viewPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	viewID := plan.ViewID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -146,7 +153,7 @@ func (r *viewPermissionResource) Create(ctx context.Context, req resource.Create
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateViewPermissions(viewID, viewPermissions)
+	_, err := client.CreateViewPermissions(ctx, viewID, viewPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating view permission",
@@ -172,10 +179,23 @@ func (r *viewPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getViewPermissionFromID(state.ID.ValueString())
-	viewPermission, err := r.client.GetViewPermission(permission.ViewID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	viewPermission, err := client.GetViewPermission(ctx, permission.ViewID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau View Permission",
+			"Could not read Tableau view permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -219,9 +239,15 @@ func (r *viewPermissionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getViewPermissionFromID(state.ID.ValueString())
 	if permission.EntityType == "users" {
-		err := r.client.DeleteViewPermission(&permission.EntityID, nil, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteViewPermission(ctx, &permission.EntityID, nil, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau View",
@@ -230,7 +256,7 @@ func (r *viewPermissionResource) Delete(ctx context.Context, req resource.Delete
 			return
 		}
 	} else {
-		err := r.client.DeleteViewPermission(nil, &permission.EntityID, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteViewPermission(ctx, nil, &permission.EntityID, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau View",
@@ -250,7 +276,7 @@ func (r *viewPermissionResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *viewPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
 
 func getViewPermissionID(viewID, entityType, entityID, capabilityName, capabilityMode string) string {
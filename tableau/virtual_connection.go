@@ -1,9 +1,13 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 type VirtualConnection struct {
@@ -21,12 +25,41 @@ type VirtualConnection struct {
 	HasExtracts bool   `json:"hasExtracts,omitempty"`
 	IsCertified bool   `json:"isCertified,omitempty"`
 	WebPageURL  string `json:"webpageUrl,omitempty"`
+
+	CertificationNote string `json:"certificationNote,omitempty"`
 }
 
 type VirtualConnectionsRequest struct {
 	VirtualConnection VirtualConnection `json:"virtualConnection"`
 }
 
+// PublishVirtualConnection is the request payload publishing a virtual
+// connection from its JSON definition
+type PublishVirtualConnection struct {
+	Name    string            `json:"name"`
+	Content string            `json:"content"`
+	Project ContentReference  `json:"project"`
+	Owner   *ContentReference `json:"owner,omitempty"`
+}
+
+type PublishVirtualConnectionRequest struct {
+	VirtualConnection PublishVirtualConnection `json:"virtualConnection"`
+}
+
+// VirtualConnectionUpdate holds the virtual connection settings that can be
+// changed without publishing its definition again
+type VirtualConnectionUpdate struct {
+	Name              string            `json:"name,omitempty"`
+	IsCertified       string            `json:"isCertified,omitempty"`
+	CertificationNote *string           `json:"certificationNote,omitempty"`
+	Project           *ContentReference `json:"project,omitempty"`
+	Owner             *ContentReference `json:"owner,omitempty"`
+}
+
+type VirtualConnectionUpdateRequest struct {
+	VirtualConnection VirtualConnectionUpdate `json:"virtualConnection"`
+}
+
 type VirtualConnectionResponse struct {
 	VirtualConnection VirtualConnection `json:"virtualConnection"`
 }
@@ -40,8 +73,16 @@ type VirtualConnectionsListResponse struct {
 	Pagination                 PaginationDetails          `json:"pagination"`
 }
 
-func (c *Client) GetVirtualConnection(ID string) (*VirtualConnection, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s", c.ApiUrl, ID), nil)
+func (r VirtualConnectionsListResponse) pageItems() []VirtualConnection {
+	return r.VirtualConnectionsResponse.VirtualConnections
+}
+
+func (r VirtualConnectionsListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetVirtualConnection(ctx context.Context, ID string) (*VirtualConnection, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/virtualconnections/%s", c.ApiUrl, ID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +100,26 @@ func (c *Client) GetVirtualConnection(ID string) (*VirtualConnection, error) {
 	return &virtualConnectionResponse.VirtualConnection, nil
 }
 
-func (c *Client) GetVirtualConnections() ([]VirtualConnection, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections", c.ApiUrl), nil)
+func (c *Client) GetVirtualConnections(ctx context.Context) ([]VirtualConnection, error) {
+	return listAll[VirtualConnection, VirtualConnectionsListResponse](ctx, c, fmt.Sprintf("%s/virtualconnections", c.ApiUrl), nil)
+}
+
+// PublishVirtualConnection creates a virtual connection from its JSON
+// definition, replacing the one of the same name in the project when
+// overwrite is set
+func (c *Client) PublishVirtualConnection(ctx context.Context, virtualConnection PublishVirtualConnection, overwrite bool) (*VirtualConnection, error) {
+	virtualConnectionRequest := PublishVirtualConnectionRequest{
+		VirtualConnection: virtualConnection,
+	}
+
+	publishVirtualConnectionJson, err := json.Marshal(virtualConnectionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("overwrite", strconv.FormatBool(overwrite))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/virtualconnections?%s", c.ApiUrl, query.Encode()), strings.NewReader(string(publishVirtualConnectionJson)))
 	if err != nil {
 		return nil, err
 	}
@@ -70,37 +129,52 @@ func (c *Client) GetVirtualConnections() ([]VirtualConnection, error) {
 		return nil, err
 	}
 
-	virtualConnectionListResponse := VirtualConnectionsListResponse{}
-	err = json.Unmarshal(body, &virtualConnectionListResponse)
+	virtualConnectionResponse := VirtualConnectionResponse{}
+	err = json.Unmarshal(body, &virtualConnectionResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &virtualConnectionResponse.VirtualConnection, nil
+}
+
+func (c *Client) UpdateVirtualConnection(ctx context.Context, virtualConnectionID string, virtualConnection VirtualConnectionUpdate) (*VirtualConnection, error) {
+	virtualConnectionRequest := VirtualConnectionUpdateRequest{
+		VirtualConnection: virtualConnection,
+	}
+
+	updateVirtualConnectionJson, err := json.Marshal(virtualConnectionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/virtualconnections/%s", c.ApiUrl, virtualConnectionID), strings.NewReader(string(updateVirtualConnectionJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(virtualConnectionListResponse.Pagination)
+	virtualConnectionResponse := VirtualConnectionResponse{}
+	err = json.Unmarshal(body, &virtualConnectionResponse)
 	if err != nil {
 		return nil, err
 	}
+	return &virtualConnectionResponse.VirtualConnection, nil
+}
+
+func (c *Client) DeleteVirtualConnection(ctx context.Context, virtualConnectionID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/virtualconnections/%s", c.ApiUrl, virtualConnectionID), nil)
+	if err != nil {
+		return err
+	}
 
-	allVirtualConnections := make([]VirtualConnection, 0, totalAvailable)
-	allVirtualConnections = append(allVirtualConnections, virtualConnectionListResponse.VirtualConnectionsResponse.VirtualConnections...)
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		virtualConnectionListResponse = VirtualConnectionsListResponse{}
-		err = json.Unmarshal(body, &virtualConnectionListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allVirtualConnections = append(allVirtualConnections, virtualConnectionListResponse.VirtualConnectionsResponse.VirtualConnections...)
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
 	}
 
-	return allVirtualConnections, nil
+	return nil
 }
@@ -1,9 +1,11 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 type VirtualConnectionConnection struct {
@@ -19,6 +21,19 @@ type VirtualConnectionConnectionRequest struct {
 	VirtualConnectionConnection VirtualConnectionConnection `json:"virtualConnectionConnections"`
 }
 
+// VirtualConnectionConnectionUpdate holds the server and credentials of one
+// of a virtual connection's database connections
+type VirtualConnectionConnectionUpdate struct {
+	ServerAddress string `json:"serverAddress,omitempty"`
+	ServerPort    string `json:"serverPort,omitempty"`
+	UserName      string `json:"userName,omitempty"`
+	Password      string `json:"password,omitempty"`
+}
+
+type VirtualConnectionConnectionUpdateRequest struct {
+	Connection VirtualConnectionConnectionUpdate `json:"connection"`
+}
+
 type VirtualConnectionConnectionsResponse struct {
 	VirtualConnectionConnections []VirtualConnectionConnection `json:"connection"`
 }
@@ -28,49 +43,44 @@ type VirtualConnectionConnectionListResponse struct {
 	Pagination                           PaginationDetails                    `json:"pagination"`
 }
 
-func (c *Client) GetVirtualConnectionConnections(virtualConnectionID string) ([]VirtualConnectionConnection, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s/connections", c.ApiUrl, virtualConnectionID), nil)
+func (r VirtualConnectionConnectionListResponse) pageItems() []VirtualConnectionConnection {
+	return r.VirtualConnectionConnectionsResponse.VirtualConnectionConnections
+}
+
+func (r VirtualConnectionConnectionListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetVirtualConnectionConnections(ctx context.Context, virtualConnectionID string) ([]VirtualConnectionConnection, error) {
+	allVirtualConnectionConnections, err := listAll[VirtualConnectionConnection, VirtualConnectionConnectionListResponse](ctx, c, fmt.Sprintf("%s/virtualconnections/%s/connections", c.ApiUrl, virtualConnectionID), nil)
 	if err != nil {
 		return nil, err
 	}
+	for idx := range allVirtualConnectionConnections {
+		allVirtualConnectionConnections[idx].VirtualConnectionID = virtualConnectionID
+	}
+	return allVirtualConnectionConnections, nil
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
+func (c *Client) UpdateVirtualConnectionConnection(ctx context.Context, virtualConnectionID, connectionID string, connection VirtualConnectionConnectionUpdate) error {
+	connectionRequest := VirtualConnectionConnectionUpdateRequest{
+		Connection: connection,
 	}
 
-	virtualConnectionConnectionsListResponse := VirtualConnectionConnectionListResponse{}
-	err = json.Unmarshal(body, &virtualConnectionConnectionsListResponse)
+	updateConnectionJson, err := json.Marshal(connectionRequest)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(virtualConnectionConnectionsListResponse.Pagination)
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/virtualconnections/%s/connections/%s/modify", c.ApiUrl, virtualConnectionID, connectionID), strings.NewReader(string(updateConnectionJson)))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	allVirtualConnectionConnections := make([]VirtualConnectionConnection, 0, totalAvailable)
-	allVirtualConnectionConnections = append(allVirtualConnectionConnections, virtualConnectionConnectionsListResponse.VirtualConnectionConnectionsResponse.VirtualConnectionConnections...)
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s/connections?pageNumber=%d", c.ApiUrl, virtualConnectionID, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		virtualConnectionConnectionsListResponse = VirtualConnectionConnectionListResponse{}
-		err = json.Unmarshal(body, &virtualConnectionConnectionsListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allVirtualConnectionConnections = append(allVirtualConnectionConnections, virtualConnectionConnectionsListResponse.VirtualConnectionConnectionsResponse.VirtualConnectionConnections...)
-	}
-	for idx := range allVirtualConnectionConnections {
-		allVirtualConnectionConnections[idx].VirtualConnectionID = virtualConnectionID
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
 	}
-	return allVirtualConnectionConnections, nil
+
+	return nil
 }
@@ -31,6 +31,7 @@ type virtualConnectionConnectionsNestedDataModel struct {
 type virtualConnectionConnectionsDataSourceModel struct {
 	ID          types.String                                  `tfsdk:"id"`
 	Connections []virtualConnectionConnectionsNestedDataModel `tfsdk:"connections"`
+	Site        types.String                                  `tfsdk:"site"`
 }
 
 func (d *virtualConnectionConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +46,7 @@ func (d *virtualConnectionConnectionsDataSource) Schema(_ context.Context, _ dat
 				Required:    true,
 				Description: "ID of the virtual connections",
 			},
+			"site": dataSourceSiteAttribute(),
 			"connections": schema.ListNestedAttribute{
 				Description: "List database connections of virtual connection and their attributes",
 				Computed:    true,
@@ -82,7 +84,14 @@ func (d *virtualConnectionConnectionsDataSource) Read(ctx context.Context, req d
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	connections, err := d.client.GetVirtualConnectionConnections(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+
+	connections, err := client.GetVirtualConnectionConnections(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Database Connections of Tableau Virtual Connection",
@@ -101,7 +110,7 @@ func (d *virtualConnectionConnectionsDataSource) Read(ctx context.Context, req d
 		}
 		state.Connections = append(state.Connections, virtualConnectionConnection)
 	}
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
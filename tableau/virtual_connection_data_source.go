@@ -27,6 +27,7 @@ type virtualConnectionDataSourceModel struct {
 	OwnerID   types.String `tfsdk:"owner_id"`
 	Content   types.String `tfsdk:"content"`
 	Name      types.String `tfsdk:"name"`
+	Site      types.String `tfsdk:"site"`
 }
 
 func (d *virtualConnectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +42,7 @@ func (d *virtualConnectionDataSource) Schema(_ context.Context, _ datasource.Sch
 				Required:    true,
 				Description: "ID of the virtual Connection",
 			},
+			"site": dataSourceSiteAttribute(),
 			"project_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Project ID of the virtual connection",
@@ -66,7 +68,14 @@ func (d *virtualConnectionDataSource) Read(ctx context.Context, req datasource.R
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	virtualConnection, err := d.client.GetVirtualConnection(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+
+	virtualConnection, err := client.GetVirtualConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Download Tableau Virtual Connection",
@@ -78,7 +87,7 @@ func (d *virtualConnectionDataSource) Read(ctx context.Context, req datasource.R
 	state.OwnerID = types.StringValue(virtualConnection.Owner.ID)
 	state.Content = types.StringValue(virtualConnection.Content)
 	state.Name = types.StringValue(virtualConnection.Name)
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
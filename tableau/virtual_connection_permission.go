@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,8 +28,8 @@ type VirtualConnectionPermissionsResponse struct {
 	VirtualConnectionPermissions VirtualConnectionPermissions `json:"permissions"`
 }
 
-func (c *Client) GetVirtualConnectionPermission(virtualConnectionID, entityID, entityType, capabilityName, capabilityMode string) (*VirtualConnectionPermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s/permissions", c.ApiUrl, virtualConnectionID), nil)
+func (c *Client) GetVirtualConnectionPermission(ctx context.Context, virtualConnectionID, entityID, entityType, capabilityName, capabilityMode string) (*VirtualConnectionPermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/virtualconnections/%s/permissions", c.ApiUrl, virtualConnectionID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +74,10 @@ func (c *Client) GetVirtualConnectionPermission(virtualConnectionID, entityID, e
 			}
 		}
 	}
-	return nil, nil
+	return nil, fmt.Errorf("Did not find %s %s permission for %s ID %s on virtual connection ID %s: %w", capabilityMode, capabilityName, entityType, entityID, virtualConnectionID, ErrNotFound)
 }
 
-func (c *Client) CreateVirtualConnectionPermissions(virtualConnectionID string, virtualConnectionPermissions VirtualConnectionPermissions) (*VirtualConnectionPermissions, error) {
+func (c *Client) CreateVirtualConnectionPermissions(ctx context.Context, virtualConnectionID string, virtualConnectionPermissions VirtualConnectionPermissions) (*VirtualConnectionPermissions, error) {
 
 	virtualConnectionPermissionsRequest := VirtualConnectionPermissionsRequest{
 		VirtualConnectionPermissions: virtualConnectionPermissions,
@@ -87,12 +88,12 @@ func (c *Client) CreateVirtualConnectionPermissions(virtualConnectionID string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/virtualconnections/%s/permissions", c.ApiUrl, virtualConnectionID), strings.NewReader(string(newVirtualConnectionPermissionsJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/virtualconnections/%s/permissions", c.ApiUrl, virtualConnectionID), strings.NewReader(string(newVirtualConnectionPermissionsJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doRequest(retryablePermissionUpdate(req))
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (c *Client) CreateVirtualConnectionPermissions(virtualConnectionID string,
 	return &virtualConnectionPermissionsResponse.VirtualConnectionPermissions, nil
 }
 
-func (c *Client) DeleteVirtualConnectionPermission(userID, groupID *string, virtualConnectionID, capabilityName, capabilityMode string) error {
+func (c *Client) DeleteVirtualConnectionPermission(ctx context.Context, userID, groupID *string, virtualConnectionID, capabilityName, capabilityMode string) error {
 	var entityID string
 	entityType := "users"
 	if userID != nil {
@@ -116,7 +117,7 @@ func (c *Client) DeleteVirtualConnectionPermission(userID, groupID *string, virt
 		entityID = *groupID
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/virtualconnections/%s/permissions/%s/%s/%s/%s", c.ApiUrl, virtualConnectionID, entityType, entityID, capabilityName, capabilityMode), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/virtualconnections/%s/permissions/%s/%s/%s/%s", c.ApiUrl, virtualConnectionID, entityType, entityID, capabilityName, capabilityMode), nil)
 
 	if err != nil {
 		return err
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &virtualConnectionPermissionResource{}
 	_ resource.ResourceWithConfigure   = &virtualConnectionPermissionResource{}
 	_ resource.ResourceWithImportState = &virtualConnectionPermissionResource{}
+	_ resource.ResourceWithModifyPlan  = &virtualConnectionPermissionResource{}
 )
 
 func NewVirtualConnectionPermissionResource() resource.Resource {
@@ -36,6 +37,7 @@ type virtualConnectionPermissionResourceModel struct {
 	GroupID             types.String `tfsdk:"group_id"`
 	CapabilityName      types.String `tfsdk:"capability_name"`
 	CapabilityMode      types.String `tfsdk:"capability_mode"`
+	Site                types.String `tfsdk:"site"`
 }
 
 func (r *virtualConnectionPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +53,7 @@ func (r *virtualConnectionPermissionResource) Schema(_ context.Context, _ resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"virtual_connection_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Virtual connection ID",
@@ -114,6 +117,12 @@ func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req re
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	virtualConnectionID := plan.VirtualConnectionID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -139,7 +148,7 @@ func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req re
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateVirtualConnectionPermissions(virtualConnectionID, virtualConnectionPermissions)
+	_, err := client.CreateVirtualConnectionPermissions(ctx, virtualConnectionID, virtualConnectionPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating virtual connection permission",
@@ -165,10 +174,23 @@ func (r *virtualConnectionPermissionResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getVirtualConnectionPermissionFromID(state.ID.ValueString())
-	virtualConnectionPermission, err := r.client.GetVirtualConnectionPermission(permission.VirtualConnectionID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	virtualConnectionPermission, err := client.GetVirtualConnectionPermission(ctx, permission.VirtualConnectionID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Virtual Connection Permission",
+			"Could not read Tableau virtual connection permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -212,9 +234,15 @@ func (r *virtualConnectionPermissionResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getVirtualConnectionPermissionFromID(state.ID.ValueString())
 	if permission.EntityType == "users" {
-		err := r.client.DeleteVirtualConnectionPermission(&permission.EntityID, nil, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteVirtualConnectionPermission(ctx, &permission.EntityID, nil, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau virtual connection",
@@ -223,7 +251,7 @@ func (r *virtualConnectionPermissionResource) Delete(ctx context.Context, req re
 			return
 		}
 	} else {
-		err := r.client.DeleteVirtualConnectionPermission(nil, &permission.EntityID, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteVirtualConnectionPermission(ctx, nil, &permission.EntityID, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau virtual connection",
@@ -234,6 +262,26 @@ func (r *virtualConnectionPermissionResource) Delete(ctx context.Context, req re
 	}
 }
 
+// ModifyPlan warns when a permission is planned against a server too old to
+// support virtual connections
+func (r *virtualConnectionPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var site types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, diags := r.client.forSite(ctx, site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+}
+
 func (r *virtualConnectionPermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -243,7 +291,7 @@ func (r *virtualConnectionPermissionResource) Configure(_ context.Context, req r
 }
 
 func (r *virtualConnectionPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
 
 func getVirtualConnectionPermissionID(virtualConnectionID, entityType, entityID, capabilityName, capabilityMode string) string {
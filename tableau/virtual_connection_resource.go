@@ -0,0 +1,538 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &virtualConnectionResource{}
+	_ resource.ResourceWithConfigure   = &virtualConnectionResource{}
+	_ resource.ResourceWithImportState = &virtualConnectionResource{}
+	_ resource.ResourceWithModifyPlan  = &virtualConnectionResource{}
+)
+
+func NewVirtualConnectionResource() resource.Resource {
+	return &virtualConnectionResource{}
+}
+
+type virtualConnectionResource struct {
+	client *Client
+}
+
+type virtualConnectionCredentialModel struct {
+	ServerAddress types.String `tfsdk:"server_address"`
+	ServerPort    types.String `tfsdk:"server_port"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+}
+
+type virtualConnectionResourceModel struct {
+	ID                types.String                       `tfsdk:"id"`
+	Name              types.String                       `tfsdk:"name"`
+	ProjectID         types.String                       `tfsdk:"project_id"`
+	Content           jsonStringValue                    `tfsdk:"content"`
+	IsCertified       types.Bool                         `tfsdk:"is_certified"`
+	CertificationNote types.String                       `tfsdk:"certification_note"`
+	OwnerID           types.String                       `tfsdk:"owner_id"`
+	Connections       []virtualConnectionCredentialModel `tfsdk:"connections"`
+	HasExtracts       types.Bool                         `tfsdk:"has_extracts"`
+	WebPageURL        types.String                       `tfsdk:"web_page_url"`
+	LastUpdated       types.String                       `tfsdk:"last_updated"`
+	Site              types.String                       `tfsdk:"site"`
+	Timeouts          timeouts.Value                     `tfsdk:"timeouts"`
+}
+
+func (r *virtualConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_connection"
+}
+
+func (r *virtualConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a virtual connection from its JSON definition",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the virtual connection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name for the virtual connection",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier for the project the virtual connection is published to",
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				CustomType:  jsonStringType{},
+				Description: "Definition of the virtual connection as JSON, compared by value so formatting and key order are ignored, changing it publishes the virtual connection again",
+				Validators: []validator.String{
+					jsonValidator{},
+				},
+			},
+			"is_certified": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Mark the virtual connection as certified, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"certification_note": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Note explaining the virtual connection's certification",
+				Default:     stringdefault.StaticString(""),
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the virtual connection owner, defaults to the user the provider signs in as",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Credentials for the database connections in the virtual connection, matched on server address and port",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_address": schema.StringAttribute{
+							Required:    true,
+							Description: "Server address of the connection",
+						},
+						"server_port": schema.StringAttribute{
+							Optional:    true,
+							Description: "Server port of the connection, when unset every connection to the server address is matched",
+						},
+						"username": schema.StringAttribute{
+							Required:    true,
+							Description: "Username for the connection",
+						},
+						"password": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for the connection",
+						},
+					},
+				},
+			},
+			"has_extracts": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the virtual connection has extracts",
+			},
+			"web_page_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the virtual connection in Tableau",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the virtual connection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// settings returns the update applying the plan's settings that are not part
+// of the definition
+func (r *virtualConnectionResource) settings(plan virtualConnectionResourceModel) VirtualConnectionUpdate {
+	certificationNote := plan.CertificationNote.ValueString()
+	virtualConnectionUpdate := VirtualConnectionUpdate{
+		Name:              plan.Name.ValueString(),
+		IsCertified:       strconv.FormatBool(plan.IsCertified.ValueBool()),
+		CertificationNote: &certificationNote,
+		Project:           &ContentReference{ID: plan.ProjectID.ValueString()},
+	}
+	if !plan.OwnerID.IsUnknown() && !plan.OwnerID.IsNull() {
+		virtualConnectionUpdate.Owner = &ContentReference{ID: plan.OwnerID.ValueString()}
+	}
+	return virtualConnectionUpdate
+}
+
+// matchesCredential reports whether a database connection is one a
+// configured credential applies to
+func matchesCredential(connection VirtualConnectionConnection, credential virtualConnectionCredentialModel) bool {
+	if connection.ServerAddress != credential.ServerAddress.ValueString() {
+		return false
+	}
+	return credential.ServerPort.IsNull() || connection.ServerPort == credential.ServerPort.ValueString()
+}
+
+// updateCredentials sets the credentials of every database connection
+// matching one of the configured connections
+func (r *virtualConnectionResource) updateCredentials(ctx context.Context, client *Client, virtualConnectionID string, credentials []virtualConnectionCredentialModel) error {
+	if len(credentials) == 0 {
+		return nil
+	}
+
+	connections, err := client.GetVirtualConnectionConnections(ctx, virtualConnectionID)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		matched := false
+		for _, connection := range connections {
+			if !matchesCredential(connection, credential) {
+				continue
+			}
+			matched = true
+			err := client.UpdateVirtualConnectionConnection(ctx, virtualConnectionID, connection.ID, VirtualConnectionConnectionUpdate{
+				ServerAddress: connection.ServerAddress,
+				ServerPort:    connection.ServerPort,
+				UserName:      credential.Username.ValueString(),
+				Password:      credential.Password.ValueString(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !matched {
+			return fmt.Errorf("no connection in the virtual connection matches server address %s", credential.ServerAddress.ValueString())
+		}
+	}
+	return nil
+}
+
+func (r *virtualConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publishedVirtualConnection, err := client.PublishVirtualConnection(ctx, PublishVirtualConnection{
+		Name:    plan.Name.ValueString(),
+		Content: plan.Content.ValueString(),
+		Project: ContentReference{ID: plan.ProjectID.ValueString()},
+	}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error publishing virtual connection",
+			"Could not publish virtual connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// certification and the owner can only be set once the virtual connection
+	// exists
+	_, err = client.UpdateVirtualConnection(ctx, publishedVirtualConnection.ID, r.settings(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Virtual Connection",
+			"Could not update the published virtual connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.updateCredentials(ctx, client, publishedVirtualConnection.ID, plan.Connections)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Virtual Connection Credentials",
+			"Could not update virtual connection credentials, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	virtualConnection, err := client.GetVirtualConnection(ctx, publishedVirtualConnection.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Virtual Connection",
+			"Could not read Tableau virtual connection ID "+publishedVirtualConnection.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(virtualConnection.ID)
+	plan.OwnerID = types.StringValue(virtualConnection.Owner.ID)
+	plan.HasExtracts = types.BoolValue(virtualConnection.HasExtracts)
+	plan.WebPageURL = types.StringValue(virtualConnection.WebPageURL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *virtualConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualConnection, err := client.GetVirtualConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Virtual Connection",
+			"Could not read Tableau virtual connection ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// the configured formatting is kept unless the definition itself changed
+	state.Content = jsonStringValueOf(virtualConnection.Content)
+
+	if len(state.Connections) > 0 {
+		connections, err := client.GetVirtualConnectionConnections(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Tableau Virtual Connection Connections",
+				"Could not read connections of Tableau virtual connection ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		for idx, credential := range state.Connections {
+			for _, connection := range connections {
+				if matchesCredential(connection, credential) && connection.UserName != credential.Username.ValueString() {
+					state.Connections[idx].Username = types.StringValue(connection.UserName)
+				}
+			}
+		}
+	}
+
+	state.ID = types.StringValue(virtualConnection.ID)
+	state.Name = types.StringValue(virtualConnection.Name)
+	state.ProjectID = types.StringValue(virtualConnection.Project.ID)
+	state.IsCertified = types.BoolValue(virtualConnection.IsCertified)
+	state.CertificationNote = types.StringValue(virtualConnection.CertificationNote)
+	state.OwnerID = types.StringValue(virtualConnection.Owner.ID)
+	state.HasExtracts = types.BoolValue(virtualConnection.HasExtracts)
+	state.WebPageURL = types.StringValue(virtualConnection.WebPageURL)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *virtualConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state virtualConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rename or move the virtual connection first, so publishing again
+	// replaces it rather than creating a new one alongside
+	_, err := client.UpdateVirtualConnection(ctx, plan.ID.ValueString(), r.settings(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Virtual Connection",
+			"Could not update virtual connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	republished := false
+	if !jsonSemanticallyEqual(plan.Content.ValueString(), state.Content.ValueString()) {
+		_, err = client.PublishVirtualConnection(ctx, PublishVirtualConnection{
+			Name:    plan.Name.ValueString(),
+			Content: plan.Content.ValueString(),
+			Project: ContentReference{ID: plan.ProjectID.ValueString()},
+			Owner:   &ContentReference{ID: plan.OwnerID.ValueString()},
+		}, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error publishing virtual connection",
+				"Could not publish virtual connection, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		republished = true
+	}
+
+	// publishing again resets the database connections, so credentials are
+	// set afresh whenever the definition changes
+	if republished || !virtualConnectionCredentialsEqual(plan.Connections, state.Connections) {
+		err = r.updateCredentials(ctx, client, plan.ID.ValueString(), plan.Connections)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Tableau Virtual Connection Credentials",
+				"Could not update virtual connection credentials, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	virtualConnection, err := client.GetVirtualConnection(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Virtual Connection",
+			"Could not read Tableau virtual connection ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.OwnerID = types.StringValue(virtualConnection.Owner.ID)
+	plan.HasExtracts = types.BoolValue(virtualConnection.HasExtracts)
+	plan.WebPageURL = types.StringValue(virtualConnection.WebPageURL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *virtualConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteVirtualConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Virtual Connection",
+			"Could not delete virtual connection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ModifyPlan warns when a virtual connection is planned against a server too
+// old to publish them
+func (r *virtualConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var site types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, diags := r.client.forSite(ctx, site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Published virtual connections", publishVirtualConnectionsApiVersion)...)
+}
+
+func (r *virtualConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *virtualConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
+
+func virtualConnectionCredentialsEqual(a, b []virtualConnectionCredentialModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].ServerAddress.Equal(b[i].ServerAddress) ||
+			!a[i].ServerPort.Equal(b[i].ServerPort) ||
+			!a[i].Username.Equal(b[i].Username) ||
+			!a[i].Password.Equal(b[i].Password) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,9 +1,8 @@
 package tableau
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 )
 
 type VirtualConnectionRevision struct {
@@ -31,45 +30,19 @@ type VirtualConnectionRevisionListResponse struct {
 	Pagination                         PaginationDetails                  `json:"pagination"`
 }
 
-func (c *Client) GetVirtualConnectionRevisions(virtualConnectionID string) ([]VirtualConnectionRevision, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s/revisions", c.ApiUrl, virtualConnectionID), nil)
-	if err != nil {
-		return nil, err
-	}
+func (r VirtualConnectionRevisionListResponse) pageItems() []VirtualConnectionRevision {
+	return r.VirtualConnectionRevisionsResponse.VirtualConnectionRevisions
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	virtualConnectionRevisionsListResponse := VirtualConnectionRevisionListResponse{}
-	err = json.Unmarshal(body, &virtualConnectionRevisionsListResponse)
-	if err != nil {
-		return nil, err
-	}
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(virtualConnectionRevisionsListResponse.Pagination)
+func (r VirtualConnectionRevisionListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetVirtualConnectionRevisions(ctx context.Context, virtualConnectionID string) ([]VirtualConnectionRevision, error) {
+	allVirtualConnectionRevisions, err := listAll[VirtualConnectionRevision, VirtualConnectionRevisionListResponse](ctx, c, fmt.Sprintf("%s/virtualconnections/%s/revisions", c.ApiUrl, virtualConnectionID), nil)
 	if err != nil {
 		return nil, err
 	}
-
-	allVirtualConnectionRevisions := make([]VirtualConnectionRevision, 0, totalAvailable)
-	allVirtualConnectionRevisions = append(allVirtualConnectionRevisions, virtualConnectionRevisionsListResponse.VirtualConnectionRevisionsResponse.VirtualConnectionRevisions...)
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/virtualconnections/%s/revisions?pageNumber=%d", c.ApiUrl, virtualConnectionID, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		virtualConnectionRevisionsListResponse = VirtualConnectionRevisionListResponse{}
-		err = json.Unmarshal(body, &virtualConnectionRevisionsListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allVirtualConnectionRevisions = append(allVirtualConnectionRevisions, virtualConnectionRevisionsListResponse.VirtualConnectionRevisionsResponse.VirtualConnectionRevisions...)
-	}
 	for idx := range allVirtualConnectionRevisions {
 		allVirtualConnectionRevisions[idx].VirtualConnectionID = virtualConnectionID
 	}
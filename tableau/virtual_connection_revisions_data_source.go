@@ -32,6 +32,7 @@ type virtualConnectionRevisionNestedDataModel struct {
 type virtualConnectionRevisionsDataSourceModel struct {
 	ID        types.String                               `tfsdk:"id"`
 	Revisions []virtualConnectionRevisionNestedDataModel `tfsdk:"revisions"`
+	Site      types.String                               `tfsdk:"site"`
 }
 
 func (d *virtualConnectionRevisionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (d *virtualConnectionRevisionsDataSource) Schema(_ context.Context, _ datas
 				Required:    true,
 				Description: "ID of the virtual connections",
 			},
+			"site": dataSourceSiteAttribute(),
 			"revisions": schema.ListNestedAttribute{
 				Description: "List database connections of virtual connection and their attributes",
 				Computed:    true,
@@ -83,7 +85,14 @@ func (d *virtualConnectionRevisionsDataSource) Read(ctx context.Context, req dat
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	revisions, err := d.client.GetVirtualConnectionRevisions(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+
+	revisions, err := client.GetVirtualConnectionRevisions(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Virtual Connection Revisions",
@@ -102,7 +111,7 @@ func (d *virtualConnectionRevisionsDataSource) Read(ctx context.Context, req dat
 		state.Revisions = append(state.Revisions, virtualConnectionRevision)
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -32,6 +32,7 @@ type virtualConnectionsNestedDataModel struct {
 type virtualConnectionsDataSourceModel struct {
 	ID                 types.String                        `tfsdk:"id"`
 	VirtualConnections []virtualConnectionsNestedDataModel `tfsdk:"virtual_connections"`
+	Site               types.String                        `tfsdk:"site"`
 }
 
 func (d *virtualConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (d *virtualConnectionsDataSource) Schema(_ context.Context, _ datasource.Sc
 				Computed:    true,
 				Description: "ID of the virtual connections",
 			},
+			"site": dataSourceSiteAttribute(),
 			"virtual_connections": schema.ListNestedAttribute{
 				Description: "List of virtual connections and their attributes",
 				Computed:    true,
@@ -83,7 +85,14 @@ func (d *virtualConnectionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	virtualConnections, err := d.client.GetVirtualConnections()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(client.requireApiVersion("Virtual connections", virtualConnectionsApiVersion)...)
+
+	virtualConnections, err := client.GetVirtualConnections(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Virtual Connection",
@@ -105,7 +114,7 @@ func (d *virtualConnectionsDataSource) Read(ctx context.Context, req datasource.
 
 	state.ID = types.StringValue("allVirtualConnections")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
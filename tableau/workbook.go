@@ -1,9 +1,13 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 type Workbook struct {
@@ -38,6 +42,10 @@ type WorkbookRequest struct {
 	Workbook Workbook `json:"workbook"`
 }
 
+type WorkbookResponse struct {
+	Workbook Workbook `json:"workbook"`
+}
+
 type WorkbooksResponse struct {
 	Workbooks []Workbook `json:"workbook"`
 }
@@ -47,8 +55,47 @@ type WorkbookListResponse struct {
 	Pagination        PaginationDetails `json:"pagination"`
 }
 
-func (c *Client) GetWorkbooks() ([]Workbook, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks", c.ApiUrl), nil)
+func (r WorkbookListResponse) pageItems() []Workbook {
+	return r.WorkbooksResponse.Workbooks
+}
+
+func (r WorkbookListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetWorkbooks(ctx context.Context) ([]Workbook, error) {
+	return listAll[Workbook, WorkbookListResponse](ctx, c, fmt.Sprintf("%s/workbooks", c.ApiUrl), nil)
+}
+
+// PublishWorkbook is the request payload sent alongside a workbook file
+type PublishWorkbook struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	ShowTabs    string              `json:"showTabs"`
+	Project     ContentReference    `json:"project"`
+	Connections *PublishConnections `json:"connections,omitempty"`
+}
+
+type PublishWorkbookRequest struct {
+	Workbook PublishWorkbook `json:"workbook"`
+}
+
+// WorkbookUpdate holds the workbook settings that can be changed without
+// publishing the file again
+type WorkbookUpdate struct {
+	Name        string            `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	ShowTabs    string            `json:"showTabs,omitempty"`
+	Project     *ContentReference `json:"project,omitempty"`
+	Owner       *ContentReference `json:"owner,omitempty"`
+}
+
+type WorkbookUpdateRequest struct {
+	Workbook WorkbookUpdate `json:"workbook"`
+}
+
+func (c *Client) GetWorkbook(ctx context.Context, workbookID string) (*Workbook, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,37 +104,81 @@ func (c *Client) GetWorkbooks() ([]Workbook, error) {
 	if err != nil {
 		return nil, err
 	}
-	workbookListResponse := WorkbookListResponse{}
-	err = json.Unmarshal(body, &workbookListResponse)
+
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workbookResponse.Workbook, nil
+}
+
+// PublishWorkbook uploads a .twb or .twbx file, replacing the workbook of the
+// same name in the project when overwrite is set. Files over 64 MB are
+// uploaded in chunks.
+func (c *Client) PublishWorkbook(ctx context.Context, filePath string, workbook PublishWorkbook, overwrite bool) (*Workbook, error) {
+	workbookType, err := publishFileType(filePath, "twb", "twbx")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("workbookType", workbookType)
+	query.Set("overwrite", strconv.FormatBool(overwrite))
+	body, err := c.publishContent(ctx, fmt.Sprintf("%s/workbooks", c.ApiUrl), query, PublishWorkbookRequest{Workbook: workbook}, "tableau_workbook", filePath)
 	if err != nil {
 		return nil, err
 	}
 
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(workbookListResponse.Pagination)
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	allWorkbooks := make([]Workbook, 0, totalAvailable)
-	allWorkbooks = append(allWorkbooks, workbookListResponse.WorkbooksResponse.Workbooks...)
-
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/workbooks?pageNumber=%d", c.ApiUrl, page), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		workbookListResponse = WorkbookListResponse{}
-		err = json.Unmarshal(body, &workbookListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allWorkbooks = append(allWorkbooks, workbookListResponse.WorkbooksResponse.Workbooks...)
+	return &workbookResponse.Workbook, nil
+}
+
+func (c *Client) UpdateWorkbook(ctx context.Context, workbookID string, workbook WorkbookUpdate) (*Workbook, error) {
+	workbookRequest := WorkbookUpdateRequest{
+		Workbook: workbook,
+	}
+
+	updateWorkbookJson, err := json.Marshal(workbookRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), strings.NewReader(string(updateWorkbookJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workbookResponse.Workbook, nil
+}
+
+func (c *Client) DeleteWorkbook(ctx context.Context, workbookID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
 	}
 
-	return allWorkbooks, nil
+	return nil
 }
@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -35,8 +36,8 @@ type WorkbookConnectionListResponse struct {
 	WorkbookConnectionsResponse WorkbookConnectionsResponse `json:"connections"`
 }
 
-func (c *Client) GetWorkbookConnections(workbookID string) ([]WorkbookConnection, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s/connections", c.ApiUrl, workbookID), nil)
+func (c *Client) GetWorkbookConnections(ctx context.Context, workbookID string) ([]WorkbookConnection, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workbooks/%s/connections", c.ApiUrl, workbookID), nil)
 	if err != nil {
 		return nil, err
 	}
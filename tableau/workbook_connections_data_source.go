@@ -37,6 +37,7 @@ type workbookConnectionNestedDataModel struct {
 type workbookConnectionsDataSourceModel struct {
 	ID          types.String                        `tfsdk:"id"`
 	Connections []workbookConnectionNestedDataModel `tfsdk:"connections"`
+	Site        types.String                        `tfsdk:"site"`
 }
 
 func (d *workbookConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,6 +52,7 @@ func (d *workbookConnectionsDataSource) Schema(_ context.Context, _ datasource.S
 				Required:    true,
 				Description: "ID of the workbook",
 			},
+			"site": dataSourceSiteAttribute(),
 			"connections": schema.ListNestedAttribute{
 				Description: "List workbook connections and their attributes",
 				Computed:    true,
@@ -108,7 +110,13 @@ func (d *workbookConnectionsDataSource) Read(ctx context.Context, req datasource
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	connections, err := d.client.GetWorkbookConnections(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, err := client.GetWorkbookConnections(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbook Connections",
@@ -132,7 +140,7 @@ func (d *workbookConnectionsDataSource) Read(ctx context.Context, req datasource
 		state.Connections = append(state.Connections, workbookConnection)
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
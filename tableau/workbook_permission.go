@@ -1,6 +1,7 @@
 package tableau
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,8 +28,8 @@ type WorkbookPermissionsResponse struct {
 	WorkbookPermissions WorkbookPermissions `json:"permissions"`
 }
 
-func (c *Client) GetWorkbookPermission(workbookID, entityID, entityType, capabilityName, capabilityMode string) (*WorkbookPermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s/permissions", c.ApiUrl, workbookID), nil)
+func (c *Client) GetWorkbookPermission(ctx context.Context, workbookID, entityID, entityType, capabilityName, capabilityMode string) (*WorkbookPermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workbooks/%s/permissions", c.ApiUrl, workbookID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +74,10 @@ func (c *Client) GetWorkbookPermission(workbookID, entityID, entityType, capabil
 			}
 		}
 	}
-	return nil, nil
+	return nil, fmt.Errorf("Did not find %s %s permission for %s ID %s on workbook ID %s: %w", capabilityMode, capabilityName, entityType, entityID, workbookID, ErrNotFound)
 }
 
-func (c *Client) CreateWorkbookPermissions(workbookID string, workbookPermissions WorkbookPermissions) (*WorkbookPermissions, error) {
+func (c *Client) CreateWorkbookPermissions(ctx context.Context, workbookID string, workbookPermissions WorkbookPermissions) (*WorkbookPermissions, error) {
 
 	workbookPermissionsRequest := WorkbookPermissionsRequest{
 		WorkbookPermissions: workbookPermissions,
@@ -87,12 +88,12 @@ func (c *Client) CreateWorkbookPermissions(workbookID string, workbookPermission
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/workbooks/%s/permissions", c.ApiUrl, workbookID), strings.NewReader(string(newWorkbookPermissionsJson)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/workbooks/%s/permissions", c.ApiUrl, workbookID), strings.NewReader(string(newWorkbookPermissionsJson)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doRequest(retryablePermissionUpdate(req))
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (c *Client) CreateWorkbookPermissions(workbookID string, workbookPermission
 	return &workbookPermissionsResponse.WorkbookPermissions, nil
 }
 
-func (c *Client) DeleteWorkbookPermission(userID, groupID *string, workbookID, capabilityName, capabilityMode string) error {
+func (c *Client) DeleteWorkbookPermission(ctx context.Context, userID, groupID *string, workbookID, capabilityName, capabilityMode string) error {
 	var entityID string
 	entityType := "users"
 	if userID != nil {
@@ -116,7 +117,7 @@ func (c *Client) DeleteWorkbookPermission(userID, groupID *string, workbookID, c
 		entityID = *groupID
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/workbooks/%s/permissions/%s/%s/%s/%s", c.ApiUrl, workbookID, entityType, entityID, capabilityName, capabilityMode), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/workbooks/%s/permissions/%s/%s/%s/%s", c.ApiUrl, workbookID, entityType, entityID, capabilityName, capabilityMode), nil)
 
 	if err != nil {
 		return err
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -36,6 +35,7 @@ type workbookPermissionResourceModel struct {
 	GroupID        types.String `tfsdk:"group_id"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
+	Site           types.String `tfsdk:"site"`
 }
 
 func (r *workbookPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +51,7 @@ func (r *workbookPermissionResource) Schema(_ context.Context, _ resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 			"workbook_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Workbook ID",
@@ -124,6 +125,12 @@ func (r *workbookPermissionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	workbookID := plan.WorkbookID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -149,7 +156,7 @@ func (r *workbookPermissionResource) Create(ctx context.Context, req resource.Cr
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateWorkbookPermissions(workbookID, workbookPermissions)
+	_, err := client.CreateWorkbookPermissions(ctx, workbookID, workbookPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workbook permission",
@@ -175,10 +182,23 @@ func (r *workbookPermissionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getWorkbookPermissionFromID(state.ID.ValueString())
-	workbookPermission, err := r.client.GetWorkbookPermission(permission.WorkbookID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	workbookPermission, err := client.GetWorkbookPermission(ctx, permission.WorkbookID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
-		resp.State.RemoveResource(ctx)
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook Permission",
+			"Could not read Tableau workbook permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
 		return
 	}
 
@@ -222,9 +242,15 @@ func (r *workbookPermissionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	permission := getWorkbookPermissionFromID(state.ID.ValueString())
 	if permission.EntityType == "users" {
-		err := r.client.DeleteWorkbookPermission(&permission.EntityID, nil, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteWorkbookPermission(ctx, &permission.EntityID, nil, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Workbook",
@@ -233,7 +259,7 @@ func (r *workbookPermissionResource) Delete(ctx context.Context, req resource.De
 			return
 		}
 	} else {
-		err := r.client.DeleteWorkbookPermission(nil, &permission.EntityID, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
+		err := client.DeleteWorkbookPermission(ctx, nil, &permission.EntityID, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Workbook",
@@ -253,7 +279,7 @@ func (r *workbookPermissionResource) Configure(_ context.Context, req resource.C
 }
 
 func (r *workbookPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughIDWithSite(ctx, req, resp)
 }
 
 func getWorkbookPermissionID(workbookID, entityType, entityID, capabilityName, capabilityMode string) string {
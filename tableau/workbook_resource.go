@@ -0,0 +1,361 @@
+package tableau
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &workbookResource{}
+	_ resource.ResourceWithConfigure   = &workbookResource{}
+	_ resource.ResourceWithImportState = &workbookResource{}
+	_ resource.ResourceWithModifyPlan  = &workbookResource{}
+)
+
+func NewWorkbookResource() resource.Resource {
+	return &workbookResource{}
+}
+
+type workbookResource struct {
+	client *Client
+}
+
+type workbookResourceModel struct {
+	ID          types.String             `tfsdk:"id"`
+	Name        types.String             `tfsdk:"name"`
+	ProjectID   types.String             `tfsdk:"project_id"`
+	FilePath    types.String             `tfsdk:"file_path"`
+	ContentHash types.String             `tfsdk:"content_hash"`
+	Description types.String             `tfsdk:"description"`
+	ShowTabs    types.Bool               `tfsdk:"show_tabs"`
+	OwnerID     types.String             `tfsdk:"owner_id"`
+	Connections []publishConnectionModel `tfsdk:"connections"`
+	WebPageURL  types.String             `tfsdk:"web_page_url"`
+	LastUpdated types.String             `tfsdk:"last_updated"`
+	Site        types.String             `tfsdk:"site"`
+	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
+}
+
+func (r *workbookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workbook"
+}
+
+func (r *workbookResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a workbook from a local .twb or .twbx file",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the workbook",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name for the workbook",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier for the project the workbook is published to",
+			},
+			"file_path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to the .twb or .twbx file to publish",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the file's content, the workbook is published again in overwrite mode whenever it changes",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description for the workbook",
+				Default:     stringdefault.StaticString(""),
+			},
+			"show_tabs": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Show views as tabs, defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the workbook owner, defaults to the user the provider signs in as",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connections": publishConnectionsAttribute("workbook"),
+			"web_page_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the workbook in Tableau",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the workbook",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan hashes the local file so a change to its content shows in the
+// plan as a change to content_hash
+func (r *workbookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPublishPlan(ctx, req, resp)
+}
+
+func (r *workbookResource) publish(ctx context.Context, client *Client, plan workbookResourceModel, overwrite bool) (*Workbook, error) {
+	workbook := PublishWorkbook{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		ShowTabs:    strconv.FormatBool(plan.ShowTabs.ValueBool()),
+		Project:     ContentReference{ID: plan.ProjectID.ValueString()},
+		Connections: publishConnectionsFromModel(plan.Connections),
+	}
+	return client.PublishWorkbook(ctx, plan.FilePath.ValueString(), workbook, overwrite)
+}
+
+func (r *workbookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workbookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workbook, err := r.publish(ctx, client, plan, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error publishing workbook",
+			"Could not publish workbook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(workbook.ID)
+	plan.WebPageURL = types.StringValue(workbook.WebPageURL)
+
+	if !plan.OwnerID.IsUnknown() && plan.OwnerID.ValueString() != workbook.Owner.ID {
+		workbook, err = client.UpdateWorkbook(ctx, workbook.ID, WorkbookUpdate{
+			Owner: &ContentReference{ID: plan.OwnerID.ValueString()},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Tableau Workbook",
+				"Could not set the owner of the published workbook, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.OwnerID = types.StringValue(workbook.Owner.ID)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workbookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workbook, err := client.GetWorkbook(ctx, state.ID.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook",
+			"Could not read Tableau workbook ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(workbook.ID)
+	state.Name = types.StringValue(workbook.Name)
+	state.ProjectID = types.StringValue(workbook.Project.ID)
+	state.Description = types.StringValue(workbook.Description)
+	state.ShowTabs = types.BoolValue(workbook.ShowTabs == "true")
+	state.OwnerID = types.StringValue(workbook.Owner.ID)
+	state.WebPageURL = types.StringValue(workbook.WebPageURL)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state workbookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, plan.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rename or move the workbook first, so publishing in overwrite mode
+	// replaces it rather than creating a new one alongside
+	description := plan.Description.ValueString()
+	workbookUpdate := WorkbookUpdate{
+		Name:        plan.Name.ValueString(),
+		Description: &description,
+		ShowTabs:    strconv.FormatBool(plan.ShowTabs.ValueBool()),
+		Project:     &ContentReference{ID: plan.ProjectID.ValueString()},
+	}
+	if !plan.OwnerID.IsUnknown() {
+		workbookUpdate.Owner = &ContentReference{ID: plan.OwnerID.ValueString()}
+	}
+	workbook, err := client.UpdateWorkbook(ctx, plan.ID.ValueString(), workbookUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Workbook",
+			"Could not update workbook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.ContentHash.Equal(state.ContentHash) || !publishConnectionsEqual(plan.Connections, state.Connections) {
+		workbook, err = r.publish(ctx, client, plan, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error publishing workbook",
+				"Could not publish workbook in overwrite mode, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.OwnerID = types.StringValue(workbook.Owner.ID)
+	if workbook.WebPageURL != "" {
+		plan.WebPageURL = types.StringValue(workbook.WebPageURL)
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workbookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, diags := r.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.DeleteWorkbook(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Workbook",
+			"Could not delete workbook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *workbookResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *workbookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIDWithSite(ctx, req, resp)
+}
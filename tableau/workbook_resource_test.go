@@ -0,0 +1,66 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccWorkbookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_workbook_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_workbook" "test" {
+  name = "test_workbook_resource"
+  project_id = tableau_project.test.id
+  file_path = "testdata/workbook.twb"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_workbook.test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.test", "content_hash"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.test", "owner_id"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.test", "last_updated"),
+					resource.TestCheckResourceAttr("tableau_workbook.test", "name", "test_workbook_resource"),
+					resource.TestCheckResourceAttr("tableau_workbook.test", "show_tabs", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_workbook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "content_hash", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test" {
+  name = "test_workbook_resource"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_workbook" "test" {
+  name = "test_workbook_resource_renamed"
+  project_id = tableau_project.test.id
+  file_path = "testdata/workbook.twb"
+  description = "Moo"
+  show_tabs = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_workbook.test", "name", "test_workbook_resource_renamed"),
+					resource.TestCheckResourceAttr("tableau_workbook.test", "description", "Moo"),
+					resource.TestCheckResourceAttr("tableau_workbook.test", "show_tabs", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -1,10 +1,8 @@
 package tableau
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
-	"strconv"
 )
 
 type WorkbookRevision struct {
@@ -32,45 +30,19 @@ type WorkbookRevisionListResponse struct {
 	Pagination                PaginationDetails         `json:"pagination"`
 }
 
-func (c *Client) GetWorkbookRevisions(workbookID string) ([]WorkbookRevision, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s/revisions", c.ApiUrl, workbookID), nil)
-	if err != nil {
-		return nil, err
-	}
+func (r WorkbookRevisionListResponse) pageItems() []WorkbookRevision {
+	return r.WorkbookRevisionsResponse.WorkbookRevisions
+}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	workbookRevisionsListResponse := WorkbookRevisionListResponse{}
-	err = json.Unmarshal(body, &workbookRevisionsListResponse)
-	if err != nil {
-		return nil, err
-	}
-	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(workbookRevisionsListResponse.Pagination)
+func (r WorkbookRevisionListResponse) pagination() PaginationDetails {
+	return r.Pagination
+}
+
+func (c *Client) GetWorkbookRevisions(ctx context.Context, workbookID string) ([]WorkbookRevision, error) {
+	allWorkbookRevisions, err := listAll[WorkbookRevision, WorkbookRevisionListResponse](ctx, c, fmt.Sprintf("%s/workbooks/%s/revisions", c.ApiUrl, workbookID), nil)
 	if err != nil {
 		return nil, err
 	}
-
-	allWorkbookRevisions := make([]WorkbookRevision, 0, totalAvailable)
-	allWorkbookRevisions = append(allWorkbookRevisions, workbookRevisionsListResponse.WorkbookRevisionsResponse.WorkbookRevisions...)
-	for page := pageNumber + 1; page <= totalPageCount; page++ {
-		fmt.Printf("Searching page %d", page)
-		req, err = http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s/revisions?pageNumber=%s", c.ApiUrl, workbookID, strconv.Itoa(page)), nil)
-		if err != nil {
-			return nil, err
-		}
-		body, err = c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-		workbookRevisionsListResponse = WorkbookRevisionListResponse{}
-		err = json.Unmarshal(body, &workbookRevisionsListResponse)
-		if err != nil {
-			return nil, err
-		}
-		allWorkbookRevisions = append(allWorkbookRevisions, workbookRevisionsListResponse.WorkbookRevisionsResponse.WorkbookRevisions...)
-	}
 	for idx := range allWorkbookRevisions {
 		allWorkbookRevisions[idx].WorkbookID = workbookID
 	}
@@ -32,6 +32,7 @@ type workbookRevisionNestedDataModel struct {
 type workbookRevisionsDataSourceModel struct {
 	ID        types.String                      `tfsdk:"id"`
 	Revisions []workbookRevisionNestedDataModel `tfsdk:"revisions"`
+	Site      types.String                      `tfsdk:"site"`
 }
 
 func (d *workbookRevisionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,7 @@ func (d *workbookRevisionsDataSource) Schema(_ context.Context, _ datasource.Sch
 				Required:    true,
 				Description: "ID of the workbook",
 			},
+			"site": dataSourceSiteAttribute(),
 			"revisions": schema.ListNestedAttribute{
 				Description: "List workbook revisions and their attributes",
 				Computed:    true,
@@ -83,7 +85,13 @@ func (d *workbookRevisionsDataSource) Read(ctx context.Context, req datasource.R
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	revisions, err := d.client.GetWorkbookRevisions(state.ID.ValueString())
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revisions, err := client.GetWorkbookRevisions(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbook Revisions",
@@ -102,7 +110,7 @@ func (d *workbookRevisionsDataSource) Read(ctx context.Context, req datasource.R
 		state.Revisions = append(state.Revisions, workbookRevision)
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
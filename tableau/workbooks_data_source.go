@@ -42,6 +42,7 @@ type workbooksNestedDataModel struct {
 type workbooksDataSourceModel struct {
 	ID        types.String               `tfsdk:"id"`
 	Workbooks []workbooksNestedDataModel `tfsdk:"workbooks"`
+	Site      types.String               `tfsdk:"site"`
 }
 
 func (d *workbooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,6 +58,7 @@ func (d *workbooksDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Computed:    true,
 				Description: "ID of the workbooks",
 			},
+			"site": dataSourceSiteAttribute(),
 			"workbooks": schema.ListNestedAttribute{
 				Description: "List of workbooks and their attributes",
 				Computed:    true,
@@ -130,7 +132,13 @@ func (d *workbooksDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	workbooks, err := d.client.GetWorkbooks()
+	client, diags := d.client.forSite(ctx, state.Site)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workbooks, err := client.GetWorkbooks(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbooks",
@@ -161,7 +169,7 @@ func (d *workbooksDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	state.ID = types.StringValue("allWorkbooks")
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return